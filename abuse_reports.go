@@ -0,0 +1,148 @@
+//
+// Copyright 2021, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package gitlab
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// ErrAbuseReportsForbidden is returned when the abuse reports API is used
+// without administrator access.
+var ErrAbuseReportsForbidden = errors.New("abuse reports can only be managed by administrators")
+
+// AbuseReportsService handles communication with the abuse reports related
+// methods of the GitLab API. Available only for admins.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/api/admin/abuse_reports.html
+type AbuseReportsService struct {
+	client *Client
+}
+
+// AbuseReport represents a GitLab abuse report.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/api/admin/abuse_reports.html
+type AbuseReport struct {
+	ID              int        `json:"id"`
+	Reporter        *BasicUser `json:"reporter"`
+	ReportedUser    *BasicUser `json:"reported_user"`
+	Category        string     `json:"category"`
+	Message         string     `json:"message"`
+	Status          string     `json:"status"`
+	ReportedFromURL string     `json:"reported_from_url"`
+	CreatedAt       *time.Time `json:"created_at"`
+	UpdatedAt       *time.Time `json:"updated_at"`
+}
+
+func (a AbuseReport) String() string {
+	return Stringify(a)
+}
+
+// ListAbuseReportsOptions represents the available ListAbuseReports() options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/admin/abuse_reports.html#list-all-abuse-reports
+type ListAbuseReportsOptions struct {
+	ListOptions
+	Status     *string `url:"status,omitempty" json:"status,omitempty"`
+	Category   *string `url:"category,omitempty" json:"category,omitempty"`
+	UserID     *int    `url:"user_id,omitempty" json:"user_id,omitempty"`
+	ReporterID *int    `url:"reporter_id,omitempty" json:"reporter_id,omitempty"`
+	Sort       *string `url:"sort,omitempty" json:"sort,omitempty"`
+}
+
+// ListAbuseReports gets a list of abuse reports.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/admin/abuse_reports.html#list-all-abuse-reports
+func (s *AbuseReportsService) ListAbuseReports(opt *ListAbuseReportsOptions, options ...RequestOptionFunc) ([]*AbuseReport, *Response, error) {
+	req, err := s.client.NewRequest(http.MethodGet, "admin/abuse_reports", opt, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var ars []*AbuseReport
+	resp, err := s.client.Do(req, &ars)
+	if err != nil {
+		return nil, resp, abuseReportsError(resp, err)
+	}
+
+	return ars, resp, nil
+}
+
+// GetAbuseReport gets a single abuse report.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/admin/abuse_reports.html#get-an-abuse-report
+func (s *AbuseReportsService) GetAbuseReport(report int, options ...RequestOptionFunc) (*AbuseReport, *Response, error) {
+	u := fmt.Sprintf("admin/abuse_reports/%d", report)
+
+	req, err := s.client.NewRequest(http.MethodGet, u, nil, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	ar := new(AbuseReport)
+	resp, err := s.client.Do(req, ar)
+	if err != nil {
+		return nil, resp, abuseReportsError(resp, err)
+	}
+
+	return ar, resp, nil
+}
+
+// UpdateAbuseReportOptions represents the available UpdateAbuseReport()
+// options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/admin/abuse_reports.html#update-an-abuse-report
+type UpdateAbuseReportOptions struct {
+	Action  *AbuseReportActionValue `url:"action,omitempty" json:"action,omitempty"`
+	Reason  *string                 `url:"reason,omitempty" json:"reason,omitempty"`
+	Comment *string                 `url:"comment,omitempty" json:"comment,omitempty"`
+	Close   *bool                   `url:"close,omitempty" json:"close,omitempty"`
+}
+
+// UpdateAbuseReport moderates an abuse report by applying the given action.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/admin/abuse_reports.html#update-an-abuse-report
+func (s *AbuseReportsService) UpdateAbuseReport(report int, opt *UpdateAbuseReportOptions, options ...RequestOptionFunc) (*AbuseReport, *Response, error) {
+	u := fmt.Sprintf("admin/abuse_reports/%d", report)
+
+	req, err := s.client.NewRequest(http.MethodPut, u, opt, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	ar := new(AbuseReport)
+	resp, err := s.client.Do(req, ar)
+	if err != nil {
+		return nil, resp, abuseReportsError(resp, err)
+	}
+
+	return ar, resp, nil
+}
+
+func abuseReportsError(resp *Response, err error) error {
+	if resp != nil && resp.StatusCode == http.StatusForbidden {
+		return &sentinelError{sentinel: ErrAbuseReportsForbidden, err: err}
+	}
+	return err
+}
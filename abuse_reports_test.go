@@ -0,0 +1,117 @@
+//
+// Copyright 2021, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package gitlab
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListAbuseReports(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/admin/abuse_reports", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testParams(t, r, "page=2&per_page=1&status=open")
+		w.Header().Set("X-Next-Page", "3")
+		fmt.Fprint(w, `[
+			{
+				"id": 2,
+				"reporter": {"id": 1, "username": "admin"},
+				"reported_user": {"id": 5, "username": "spammer"},
+				"category": "spam",
+				"message": "Posts links everywhere",
+				"status": "open",
+				"created_at": "2024-03-01T10:00:00Z"
+			}
+		]`)
+	})
+
+	opt := &ListAbuseReportsOptions{
+		ListOptions: ListOptions{Page: 2, PerPage: 1},
+		Status:      Ptr("open"),
+	}
+	reports, resp, err := client.AbuseReports.ListAbuseReports(opt)
+	require.NoError(t, err)
+	assert.Equal(t, 3, resp.NextPage)
+
+	createdAt := time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC)
+	want := []*AbuseReport{{
+		ID:           2,
+		Reporter:     &BasicUser{ID: 1, Username: "admin"},
+		ReportedUser: &BasicUser{ID: 5, Username: "spammer"},
+		Category:     "spam",
+		Message:      "Posts links everywhere",
+		Status:       "open",
+		CreatedAt:    &createdAt,
+	}}
+	assert.Equal(t, want, reports)
+}
+
+func TestListAbuseReportsForbidden(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/admin/abuse_reports", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+		fmt.Fprint(w, `{"message":"403 Forbidden"}`)
+	})
+
+	_, resp, err := client.AbuseReports.ListAbuseReports(nil)
+	require.ErrorIs(t, err, ErrAbuseReportsForbidden)
+	assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+
+	var errResp *ErrorResponse
+	require.ErrorAs(t, err, &errResp)
+	assert.Equal(t, "{message: 403 Forbidden}", errResp.Message)
+}
+
+func TestGetAbuseReport(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/admin/abuse_reports/2", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{"id": 2, "status": "open", "category": "spam"}`)
+	})
+
+	report, _, err := client.AbuseReports.GetAbuseReport(2)
+	require.NoError(t, err)
+	assert.Equal(t, &AbuseReport{ID: 2, Status: "open", Category: "spam"}, report)
+}
+
+func TestUpdateAbuseReport(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/admin/abuse_reports/2", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPut)
+		testBody(t, r, `{"action":"close_report","reason":"spam","comment":"Handled"}`)
+		fmt.Fprint(w, `{"id": 2, "status": "closed", "category": "spam"}`)
+	})
+
+	opt := &UpdateAbuseReportOptions{
+		Action:  Ptr(CloseReportAbuseReportAction),
+		Reason:  Ptr("spam"),
+		Comment: Ptr("Handled"),
+	}
+	report, _, err := client.AbuseReports.UpdateAbuseReport(2, opt)
+	require.NoError(t, err)
+	assert.Equal(t, "closed", report.Status)
+}
@@ -33,6 +33,26 @@ func WithBaseURL(urlStr string) ClientOptionFunc {
 	}
 }
 
+// WithClientName sets the X-Gitlab-Client-Name header sent with every
+// request, which GitLab administrators can use to attribute API traffic.
+func WithClientName(name string) ClientOptionFunc {
+	return func(c *Client) error {
+		c.clientName = name
+		return nil
+	}
+}
+
+// WithCorrelationIDHeader can be used to configure the header used to send
+// the correlation ID set with WithCorrelationID, for example when a proxy in
+// front of GitLab expects a different header. An empty value keeps the
+// default X-Request-Id header.
+func WithCorrelationIDHeader(headerName string) ClientOptionFunc {
+	return func(c *Client) error {
+		c.correlationIDHeader = headerName
+		return nil
+	}
+}
+
 // WithCustomBackoff can be used to configure a custom backoff policy.
 func WithCustomBackoff(backoff retryablehttp.Backoff) ClientOptionFunc {
 	return func(c *Client) error {
@@ -93,6 +113,29 @@ func WithCustomRetryWaitMinMax(waitMin, waitMax time.Duration) ClientOptionFunc
 	}
 }
 
+// WithCustomUnmarshaler can be used to configure the function used to decode
+// response bodies, for example to handle fields whose format does not parse
+// into the types of this package. It receives the raw body and the value to
+// decode into. A nil function keeps the default encoding/json behavior.
+func WithCustomUnmarshaler(fn func([]byte, interface{}) error) ClientOptionFunc {
+	return func(c *Client) error {
+		c.unmarshaler = fn
+		return nil
+	}
+}
+
+// WithRequestInterceptor can be used to configure a function that is called
+// with every outgoing request just before it is sent, after the authentication
+// headers are set. It can be used to inject headers derived from the request
+// context, for example to propagate distributed tracing spans. Changes to the
+// Authorization, JOB-TOKEN and PRIVATE-TOKEN headers are discarded.
+func WithRequestInterceptor(fn func(*http.Request)) ClientOptionFunc {
+	return func(c *Client) error {
+		c.requestInterceptor = fn
+		return nil
+	}
+}
+
 // WithErrorHandler can be used to configure a custom error handler.
 func WithErrorHandler(handler retryablehttp.ErrorHandler) ClientOptionFunc {
 	return func(c *Client) error {
@@ -140,3 +183,14 @@ func WithRequestOptions(options ...RequestOptionFunc) ClientOptionFunc {
 		return nil
 	}
 }
+
+// WithUserAgent overrides the default go-gitlab/<version> user agent. An
+// empty value keeps the default.
+func WithUserAgent(ua string) ClientOptionFunc {
+	return func(c *Client) error {
+		if ua != "" {
+			c.UserAgent = ua
+		}
+		return nil
+	}
+}
@@ -17,6 +17,7 @@
 package gitlab
 
 import (
+	"errors"
 	"fmt"
 	"net/http"
 	"net/url"
@@ -87,6 +88,10 @@ type ListCommitsOptions struct {
 
 // ListCommits gets a list of repository commits in a project.
 //
+// To page through a large history efficiently, set ListOptions.Pagination to
+// "keyset" and pass the Response.NextLink of each page to the next request
+// using WithKeysetPaginationParameters, until NextLink is empty.
+//
 // GitLab API docs: https://docs.gitlab.com/ee/api/commits.html#list-repository-commits
 func (s *CommitsService) ListCommits(pid interface{}, opt *ListCommitsOptions, options ...RequestOptionFunc) ([]*Commit, *Response, error) {
 	project, err := parseID(pid)
@@ -358,9 +363,9 @@ func (s *CommitsService) GetCommitComments(pid interface{}, sha string, opt *Get
 // https://docs.gitlab.com/ee/api/commits.html#post-comment-to-commit
 type PostCommitCommentOptions struct {
 	Note     *string `url:"note,omitempty" json:"note,omitempty"`
-	Path     *string `url:"path" json:"path"`
-	Line     *int    `url:"line" json:"line"`
-	LineType *string `url:"line_type" json:"line_type"`
+	Path     *string `url:"path,omitempty" json:"path,omitempty"`
+	Line     *int    `url:"line,omitempty" json:"line,omitempty"`
+	LineType *string `url:"line_type,omitempty" json:"line_type,omitempty"`
 }
 
 // PostCommitComment adds a comment to a commit. Optionally you can post
@@ -459,7 +464,8 @@ type SetCommitStatusOptions struct {
 	PipelineID  *int            `url:"pipeline_id,omitempty" json:"pipeline_id,omitempty"`
 }
 
-// SetCommitStatus sets the status of a commit in a project.
+// SetCommitStatus sets the status of a commit in a project. The state must be
+// one of pending, running, success, failed or canceled.
 //
 // GitLab API docs: https://docs.gitlab.com/ee/api/commits.html#set-the-pipeline-status-of-a-commit
 func (s *CommitsService) SetCommitStatus(pid interface{}, sha string, opt *SetCommitStatusOptions, options ...RequestOptionFunc) (*CommitStatus, *Response, error) {
@@ -467,6 +473,14 @@ func (s *CommitsService) SetCommitStatus(pid interface{}, sha string, opt *SetCo
 	if err != nil {
 		return nil, nil, err
 	}
+	if opt == nil {
+		return nil, nil, errors.New("state is required to set a commit status")
+	}
+	switch opt.State {
+	case Pending, Running, Success, Failed, Canceled:
+	default:
+		return nil, nil, fmt.Errorf("invalid commit status state %q", opt.State)
+	}
 	u := fmt.Sprintf("projects/%s/statuses/%s", PathEscape(project), url.PathEscape(sha))
 
 	req, err := s.client.NewRequest(http.MethodPost, u, opt, options)
@@ -32,6 +32,74 @@ import (
 
 var testRevertCommitTargetBranch = "release"
 
+func TestListCommitsKeysetPagination(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/1/repository/commits", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+
+		q := r.URL.Query()
+		for k, want := range map[string]string{
+			"pagination":   "keyset",
+			"per_page":     "1",
+			"order_by":     "created_at",
+			"sort":         "desc",
+			"ref_name":     "main",
+			"path":         "README.md",
+			"all":          "true",
+			"first_parent": "true",
+			"since":        "2024-01-01T00:00:00Z",
+			"until":        "2024-02-01T00:00:00Z",
+		} {
+			if got := q.Get(k); got != want {
+				t.Errorf("query parameter %s is %q, want %q", k, got, want)
+			}
+		}
+
+		if q.Get("page_token") == "" {
+			next := "https://gitlab.example.com/api/v4/projects/1/repository/commits?" +
+				"pagination=keyset&per_page=1&order_by=created_at&sort=desc&page_token=abc"
+			w.Header().Set("Link", fmt.Sprintf("<%s>; rel=\"next\"", next))
+			fmt.Fprint(w, `[{"id":"b"}]`)
+			return
+		}
+		fmt.Fprint(w, `[{"id":"a"}]`)
+	})
+
+	since := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	until := time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)
+	opt := &ListCommitsOptions{
+		ListOptions: ListOptions{
+			Pagination: "keyset",
+			PerPage:    1,
+			OrderBy:    "created_at",
+			Sort:       "desc",
+		},
+		RefName:     Ptr("main"),
+		Path:        Ptr("README.md"),
+		All:         Ptr(true),
+		FirstParent: Ptr(true),
+		Since:       &since,
+		Until:       &until,
+	}
+
+	var ids []string
+	var options []RequestOptionFunc
+	for {
+		commits, resp, err := client.Commits.ListCommits(1, opt, options...)
+		require.NoError(t, err)
+		for _, c := range commits {
+			ids = append(ids, c.ID)
+		}
+		if resp.NextLink == "" {
+			break
+		}
+		options = []RequestOptionFunc{WithKeysetPaginationParameters(resp.NextLink)}
+	}
+
+	assert.Equal(t, []string{"b", "a"}, ids)
+}
+
 func TestGetCommit(t *testing.T) {
 	mux, client := setup(t)
 
@@ -137,6 +205,20 @@ func TestSetCommitStatus(t *testing.T) {
 	}
 }
 
+func TestSetCommitStatusInvalidState(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/1/statuses/b0b3a907f41409829b307a28b82fdbd552ee5a27", func(w http.ResponseWriter, r *http.Request) {
+		t.Fatal("SetCommitStatus should not send a request for an invalid state")
+	})
+
+	_, _, err := client.Commits.SetCommitStatus("1", "b0b3a907f41409829b307a28b82fdbd552ee5a27", &SetCommitStatusOptions{State: Manual})
+	assert.EqualError(t, err, `invalid commit status state "manual"`)
+
+	_, _, err = client.Commits.SetCommitStatus("1", "b0b3a907f41409829b307a28b82fdbd552ee5a27", nil)
+	assert.EqualError(t, err, "state is required to set a commit status")
+}
+
 func TestRevertCommit_NoOptions(t *testing.T) {
 	mux, client := setup(t)
 
@@ -525,6 +607,45 @@ func TestCommitsService_GetCommitDiff(t *testing.T) {
 	require.Equal(t, http.StatusNotFound, resp.StatusCode)
 }
 
+func TestCommitsService_GetCommitDiffPagination(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/1/repository/commits/master/diff", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		switch r.URL.Query().Get("page") {
+		case "1":
+			testParams(t, r, "page=1&per_page=1&unidiff=true")
+			w.Header().Set("X-Next-Page", "2")
+			fmt.Fprint(w, `[{"diff":"@@ -1 +1 @@\n-a\n+b\n","old_path":"a.go","new_path":"a.go","a_mode":"100644","b_mode":"100644"}]`)
+		case "2":
+			testParams(t, r, "page=2&per_page=1&unidiff=true")
+			fmt.Fprint(w, `[{"diff":"@@ -0,0 +1 @@\n+c\n","old_path":"c.go","new_path":"c.go","a_mode":"0","b_mode":"100644","new_file":true}]`)
+		default:
+			t.Errorf("unexpected page %q", r.URL.Query().Get("page"))
+		}
+	})
+
+	opt := &GetCommitDiffOptions{
+		ListOptions: ListOptions{Page: 1, PerPage: 1},
+		Unidiff:     Ptr(true),
+	}
+
+	var paths []string
+	for {
+		ds, resp, err := client.Commits.GetCommitDiff(1, "master", opt)
+		require.NoError(t, err)
+		for _, d := range ds {
+			paths = append(paths, d.NewPath)
+		}
+		if resp.NextPage == 0 {
+			break
+		}
+		opt.Page = resp.NextPage
+	}
+
+	require.Equal(t, []string{"a.go", "c.go"}, paths)
+}
+
 func TestCommitsService_GetCommitComments(t *testing.T) {
 	mux, client := setup(t)
 
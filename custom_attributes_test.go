@@ -240,3 +240,28 @@ func TestDeleteCustomProjectAttribute(t *testing.T) {
 		t.Errorf("CustomAttribute.DeleteCustomProjectAttribute returned %d, want %d", got, want)
 	}
 }
+
+func TestSetCustomProjectAttributeEscapesKey(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/2/custom_attributes/", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPut)
+		if got, want := r.URL.EscapedPath(), "/api/v4/projects/2/custom_attributes/cost%2Fcenter"; got != want {
+			t.Errorf("Request path: %s, want %s", got, want)
+		}
+		fmt.Fprint(w, `{"key":"cost/center", "value":"1234"}`)
+	})
+
+	customAttribute, _, err := client.CustomAttribute.SetCustomProjectAttribute(2, CustomAttribute{
+		Key:   "cost/center",
+		Value: "1234",
+	})
+	if err != nil {
+		t.Errorf("CustomAttribute.SetCustomProjectAttribute returned error: %v", err)
+	}
+
+	want := &CustomAttribute{Key: "cost/center", Value: "1234"}
+	if !reflect.DeepEqual(want, customAttribute) {
+		t.Errorf("CustomAttribute.SetCustomProjectAttribute returned %+v, want %+v", customAttribute, want)
+	}
+}
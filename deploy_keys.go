@@ -38,6 +38,7 @@ type InstanceDeployKey struct {
 	CreatedAt               *time.Time          `json:"created_at"`
 	Key                     string              `json:"key"`
 	Fingerprint             string              `json:"fingerprint"`
+	ExpiresAt               *time.Time          `json:"expires_at"`
 	ProjectsWithWriteAccess []*DeployKeyProject `json:"projects_with_write_access"`
 }
 
@@ -135,6 +136,70 @@ func (s *DeployKeysService) ListProjectDeployKeys(pid interface{}, opt *ListProj
 	return ks, resp, nil
 }
 
+// ListExpiringDeployKeys gets all deploy keys of the instance that expire
+// before the given time, including keys that already expired. Keys without an
+// expiry date never expire and are left out. Requires administrator access.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/deploy_keys.html#list-all-deploy-keys
+func (s *DeployKeysService) ListExpiringDeployKeys(before time.Time, options ...RequestOptionFunc) ([]*InstanceDeployKey, *Response, error) {
+	var expiring []*InstanceDeployKey
+	var resp *Response
+
+	opt := &ListInstanceDeployKeysOptions{ListOptions: ListOptions{PerPage: 100}}
+	for {
+		ks, r, err := s.ListAllDeployKeys(opt, options...)
+		if err != nil {
+			return nil, r, err
+		}
+		for _, k := range ks {
+			if k.ExpiresAt != nil && k.ExpiresAt.Before(before) {
+				expiring = append(expiring, k)
+			}
+		}
+		resp = r
+
+		if r.NextPage == 0 {
+			break
+		}
+		opt.Page = r.NextPage
+	}
+
+	return expiring, resp, nil
+}
+
+// ListExpiringProjectDeployKeys gets the deploy keys of a project that expire
+// before the given time, including keys that already expired. Keys without an
+// expiry date never expire and are left out.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/deploy_keys.html#list-deploy-keys-for-project
+func (s *DeployKeysService) ListExpiringProjectDeployKeys(pid interface{}, before time.Time, options ...RequestOptionFunc) ([]*ProjectDeployKey, *Response, error) {
+	var expiring []*ProjectDeployKey
+	var resp *Response
+
+	opt := &ListProjectDeployKeysOptions{PerPage: 100}
+	for {
+		ks, r, err := s.ListProjectDeployKeys(pid, opt, options...)
+		if err != nil {
+			return nil, r, err
+		}
+		for _, k := range ks {
+			if k.ExpiresAt != nil && k.ExpiresAt.Before(before) {
+				expiring = append(expiring, k)
+			}
+		}
+		resp = r
+
+		if r.NextPage == 0 {
+			break
+		}
+		opt.Page = r.NextPage
+	}
+
+	return expiring, resp, nil
+}
+
 // GetDeployKey gets a single deploy key.
 //
 // GitLab API docs:
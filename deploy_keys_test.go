@@ -179,6 +179,66 @@ func TestListProjectDeployKeys(t *testing.T) {
 	}
 }
 
+func TestListExpiringProjectDeployKeys(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/5/deploy_keys", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		if r.URL.Query().Get("page") == "2" {
+			fmt.Fprint(w, `[
+				{"id": 3, "title": "Expired key", "expires_at": "2024-05-20T00:00:00Z"},
+				{"id": 4, "title": "Later key", "expires_at": "2024-09-01T00:00:00Z"}
+			]`)
+			return
+		}
+		w.Header().Set("X-Next-Page", "2")
+		fmt.Fprint(w, `[
+			{"id": 1, "title": "Expiring key", "expires_at": "2024-06-15T00:00:00Z"},
+			{"id": 2, "title": "Never expiring key", "expires_at": null}
+		]`)
+	})
+
+	now := time.Date(2024, time.June, 1, 0, 0, 0, 0, time.UTC)
+	deployKeys, _, err := client.DeployKeys.ListExpiringProjectDeployKeys(5, now.AddDate(0, 0, 30))
+	if err != nil {
+		t.Fatalf("DeployKeys.ListExpiringProjectDeployKeys returned error: %v", err)
+	}
+
+	var ids []int
+	for _, k := range deployKeys {
+		ids = append(ids, k.ID)
+	}
+	want := []int{1, 3}
+	if !reflect.DeepEqual(want, ids) {
+		t.Errorf("DeployKeys.ListExpiringProjectDeployKeys returned keys %v, want %v", ids, want)
+	}
+}
+
+func TestListExpiringDeployKeys(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/deploy_keys", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `[
+			{"id": 1, "title": "Expiring key", "expires_at": "2024-06-15T00:00:00Z"},
+			{"id": 2, "title": "Never expiring key"},
+			{"id": 3, "title": "Later key", "expires_at": "2024-09-01T00:00:00Z"}
+		]`)
+	})
+
+	now := time.Date(2024, time.June, 1, 0, 0, 0, 0, time.UTC)
+	deployKeys, _, err := client.DeployKeys.ListExpiringDeployKeys(now.AddDate(0, 0, 30))
+	if err != nil {
+		t.Fatalf("DeployKeys.ListExpiringDeployKeys returned error: %v", err)
+	}
+
+	expiresAt := time.Date(2024, time.June, 15, 0, 0, 0, 0, time.UTC)
+	want := []*InstanceDeployKey{{ID: 1, Title: "Expiring key", ExpiresAt: &expiresAt}}
+	if !reflect.DeepEqual(want, deployKeys) {
+		t.Errorf("DeployKeys.ListExpiringDeployKeys returned %+v, want %+v", deployKeys, want)
+	}
+}
+
 func TestGetDeployKey(t *testing.T) {
 	mux, client := setup(t)
 
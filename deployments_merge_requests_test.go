@@ -29,7 +29,7 @@ func TestDeploymentMergeRequestsService_ListDeploymentMergeRequests(t *testing.T
 	require.Equal(t, 3, len(mergeRequests))
 
 	validStates := []string{"opened", "closed", "locked", "merged"}
-	detailedMergeStatuses := []string{
+	detailedMergeStatuses := []DetailedMergeStatusValue{
 		"blocked_status",
 		"broken_status",
 		"checking",
@@ -17,6 +17,7 @@
 package gitlab
 
 import (
+	"errors"
 	"fmt"
 	"net/http"
 	"time"
@@ -759,8 +760,50 @@ type LinePositionOptions struct {
 	Type     *string `url:"type,omitempty" json:"type,omitempty"`
 }
 
+// validate checks that a diff position carries all required SHAs and a known
+// position type before it is sent.
+func (opt *CreateMergeRequestDiscussionOptions) validate() error {
+	if opt == nil || opt.Position == nil {
+		return nil
+	}
+	p := opt.Position
+	return validatePosition(
+		stringValue(p.BaseSHA),
+		stringValue(p.StartSHA),
+		stringValue(p.HeadSHA),
+		stringValue(p.PositionType),
+	)
+}
+
+// validatePosition checks the attributes GitLab requires for every diff
+// position.
+func validatePosition(baseSHA, startSHA, headSHA, positionType string) error {
+	switch {
+	case baseSHA == "":
+		return errors.New("position: base_sha is required")
+	case startSHA == "":
+		return errors.New("position: start_sha is required")
+	case headSHA == "":
+		return errors.New("position: head_sha is required")
+	}
+	switch positionType {
+	case "text", "image", "file":
+		return nil
+	default:
+		return fmt.Errorf("position: invalid position_type %q, must be text, image or file", positionType)
+	}
+}
+
+func stringValue(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
 // CreateMergeRequestDiscussion creates a new discussion for a single merge
-// request.
+// request. When a position is given, its SHAs and position type are checked
+// before the request is sent.
 //
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/discussions.html#create-new-merge-request-thread
@@ -769,6 +812,9 @@ func (s *DiscussionsService) CreateMergeRequestDiscussion(pid interface{}, merge
 	if err != nil {
 		return nil, nil, err
 	}
+	if err := opt.validate(); err != nil {
+		return nil, nil, err
+	}
 	u := fmt.Sprintf("projects/%s/merge_requests/%d/discussions",
 		PathEscape(project),
 		mergeRequest,
@@ -827,6 +873,73 @@ func (s *DiscussionsService) ResolveMergeRequestDiscussion(pid interface{}, merg
 	return d, resp, nil
 }
 
+// ResolveDiscussionsResult represents the outcome of
+// ResolveAllMergeRequestDiscussions. Resolved holds the IDs of the resolved
+// discussions, while Failed holds the error for every discussion ID that
+// could not be resolved.
+type ResolveDiscussionsResult struct {
+	Resolved []string
+	Failed   map[string]error
+}
+
+// ResolveAllMergeRequestDiscussions resolves all unresolved threads of a
+// merge request. System discussions and threads that are not resolvable or
+// already resolved are skipped. A failure to resolve one thread does not
+// abort the others; it is recorded in the Failed map of the result instead.
+// An error is only returned when the discussions could not be listed.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/discussions.html#resolve-a-merge-request-thread
+func (s *DiscussionsService) ResolveAllMergeRequestDiscussions(pid interface{}, mergeRequest int, options ...RequestOptionFunc) (*ResolveDiscussionsResult, *Response, error) {
+	var discussions []*Discussion
+	var resp *Response
+
+	opt := &ListMergeRequestDiscussionsOptions{PerPage: 100}
+	for {
+		ds, r, err := s.ListMergeRequestDiscussions(pid, mergeRequest, opt, options...)
+		if err != nil {
+			return nil, r, err
+		}
+		discussions = append(discussions, ds...)
+		resp = r
+
+		if r.NextPage == 0 {
+			break
+		}
+		opt.Page = r.NextPage
+	}
+
+	result := &ResolveDiscussionsResult{Failed: make(map[string]error)}
+	ropt := &ResolveMergeRequestDiscussionOptions{Resolved: Ptr(true)}
+	for _, d := range discussions {
+		if !unresolvedDiscussion(d) {
+			continue
+		}
+		if _, r, err := s.ResolveMergeRequestDiscussion(pid, mergeRequest, d.ID, ropt, options...); err != nil {
+			result.Failed[d.ID] = err
+		} else {
+			result.Resolved = append(result.Resolved, d.ID)
+			resp = r
+		}
+	}
+
+	return result, resp, nil
+}
+
+// unresolvedDiscussion reports whether d is a user thread with at least one
+// resolvable note that is not resolved yet.
+func unresolvedDiscussion(d *Discussion) bool {
+	if len(d.Notes) == 0 || d.Notes[0].System {
+		return false
+	}
+	for _, n := range d.Notes {
+		if n.Resolvable && !n.Resolved {
+			return true
+		}
+	}
+	return false
+}
+
 // AddMergeRequestDiscussionNoteOptions represents the available
 // AddMergeRequestDiscussionNote() options.
 //
@@ -1011,7 +1124,19 @@ type CreateCommitDiscussionOptions struct {
 	Position  *NotePosition `url:"position,omitempty" json:"position,omitempty"`
 }
 
+// validate checks that a diff position carries all required SHAs and a known
+// position type before it is sent.
+func (opt *CreateCommitDiscussionOptions) validate() error {
+	if opt == nil || opt.Position == nil {
+		return nil
+	}
+	p := opt.Position
+	return validatePosition(p.BaseSHA, p.StartSHA, p.HeadSHA, p.PositionType)
+}
+
 // CreateCommitDiscussion creates a new discussion to a single project commit.
+// When a position is given, its SHAs and position type are checked before the
+// request is sent.
 //
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/discussions.html#create-new-commit-thread
@@ -1020,6 +1145,9 @@ func (s *DiscussionsService) CreateCommitDiscussion(pid interface{}, commit stri
 	if err != nil {
 		return nil, nil, err
 	}
+	if err := opt.validate(); err != nil {
+		return nil, nil, err
+	}
 	u := fmt.Sprintf("projects/%s/repository/commits/%s/discussions",
 		PathEscape(project),
 		commit,
@@ -1,6 +1,7 @@
 package gitlab
 
 import (
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"testing"
@@ -2004,6 +2005,55 @@ func TestDiscussionsService_ResolveMergeRequestDiscussion(t *testing.T) {
 	require.Equal(t, http.StatusNotFound, resp.StatusCode)
 }
 
+func TestDiscussionsService_ResolveAllMergeRequestDiscussions(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/5/merge_requests/11/discussions", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		switch r.URL.Query().Get("page") {
+		case "":
+			w.Header().Set("X-Next-Page", "2")
+			fmt.Fprint(w, `[
+				{"id": "open", "notes": [{"id": 1, "resolvable": true, "resolved": false}]},
+				{"id": "system", "notes": [{"id": 2, "system": true, "resolvable": false}]},
+				{"id": "done", "notes": [{"id": 3, "resolvable": true, "resolved": true}]}
+			]`)
+		case "2":
+			fmt.Fprint(w, `[
+				{"id": "comment", "individual_note": true, "notes": [{"id": 4, "resolvable": false}]},
+				{"id": "partly", "notes": [{"id": 5, "resolvable": true, "resolved": true}, {"id": 6, "resolvable": true, "resolved": false}]},
+				{"id": "locked", "notes": [{"id": 7, "resolvable": true, "resolved": false}]}
+			]`)
+		default:
+			t.Errorf("unexpected page %q", r.URL.Query().Get("page"))
+		}
+	})
+
+	for _, id := range []string{"open", "partly"} {
+		mux.HandleFunc("/api/v4/projects/5/merge_requests/11/discussions/"+id, func(w http.ResponseWriter, r *http.Request) {
+			testMethod(t, r, http.MethodPut)
+			testBody(t, r, `{"resolved":true}`)
+			fmt.Fprint(w, `{"id": "resolved"}`)
+		})
+	}
+	mux.HandleFunc("/api/v4/projects/5/merge_requests/11/discussions/locked", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPut)
+		w.WriteHeader(http.StatusForbidden)
+		fmt.Fprint(w, `{"message": "403 Forbidden"}`)
+	})
+
+	result, _, err := client.Discussions.ResolveAllMergeRequestDiscussions(5, 11)
+	require.NoError(t, err)
+	require.Equal(t, []string{"open", "partly"}, result.Resolved)
+	require.Len(t, result.Failed, 1)
+	require.Contains(t, result.Failed["locked"].Error(), "403")
+
+	result, resp, err := client.Discussions.ResolveAllMergeRequestDiscussions(5.01, 11)
+	require.EqualError(t, err, "invalid ID type 5.01, the ID must be an int or a string")
+	require.Nil(t, resp)
+	require.Nil(t, result)
+}
+
 func TestDiscussionsService_AddMergeRequestDiscussionNote(t *testing.T) {
 	mux, client := setup(t)
 
@@ -2530,6 +2580,83 @@ func TestDiscussionsService_CreateCommitDiscussion(t *testing.T) {
 	require.Equal(t, http.StatusNotFound, resp.StatusCode)
 }
 
+func TestDiscussionsService_CreateMergeRequestDiscussionWithPosition(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/5/merge_requests/11/discussions", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		var opt CreateMergeRequestDiscussionOptions
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&opt))
+		require.NotNil(t, opt.Position)
+		require.Equal(t, "head", *opt.Position.HeadSHA)
+		require.Equal(t, 12, *opt.Position.NewLine)
+		require.Equal(t, "10_10", *opt.Position.LineRange.Start.LineCode)
+		fmt.Fprint(w, `{"id": "6a9c1750b37d513a43987b574953fceb50b03ce7"}`)
+	})
+
+	opt := &CreateMergeRequestDiscussionOptions{
+		Body: Ptr("needs a comment"),
+		Position: &PositionOptions{
+			BaseSHA:      Ptr("base"),
+			StartSHA:     Ptr("start"),
+			HeadSHA:      Ptr("head"),
+			PositionType: Ptr("text"),
+			NewPath:      Ptr("main.go"),
+			OldPath:      Ptr("main.go"),
+			NewLine:      Ptr(12),
+			LineRange: &LineRangeOptions{
+				Start: &LinePositionOptions{LineCode: Ptr("10_10"), Type: Ptr("new")},
+				End:   &LinePositionOptions{LineCode: Ptr("12_12"), Type: Ptr("new")},
+			},
+		},
+	}
+
+	d, _, err := client.Discussions.CreateMergeRequestDiscussion(5, 11, opt)
+	require.NoError(t, err)
+	require.Equal(t, "6a9c1750b37d513a43987b574953fceb50b03ce7", d.ID)
+
+	opt.Position.StartSHA = nil
+	d, resp, err := client.Discussions.CreateMergeRequestDiscussion(5, 11, opt)
+	require.EqualError(t, err, "position: start_sha is required")
+	require.Nil(t, resp)
+	require.Nil(t, d)
+
+	opt.Position.StartSHA = Ptr("start")
+	opt.Position.PositionType = Ptr("line")
+	_, _, err = client.Discussions.CreateMergeRequestDiscussion(5, 11, opt)
+	require.EqualError(t, err, `position: invalid position_type "line", must be text, image or file`)
+}
+
+func TestDiscussionsService_CreateCommitDiscussionWithPosition(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/5/repository/commits/abc123/discussions", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		fmt.Fprint(w, `{"id": "6a9c1750b37d513a43987b574953fceb50b03ce7"}`)
+	})
+
+	opt := &CreateCommitDiscussionOptions{
+		Body: Ptr("needs a comment"),
+		Position: &NotePosition{
+			BaseSHA:      "base",
+			StartSHA:     "start",
+			HeadSHA:      "head",
+			PositionType: "text",
+			NewPath:      "main.go",
+			NewLine:      12,
+		},
+	}
+
+	_, _, err := client.Discussions.CreateCommitDiscussion(5, "abc123", opt)
+	require.NoError(t, err)
+
+	opt.Position.BaseSHA = ""
+	d, resp, err := client.Discussions.CreateCommitDiscussion(5, "abc123", opt)
+	require.EqualError(t, err, "position: base_sha is required")
+	require.Nil(t, resp)
+	require.Nil(t, d)
+}
+
 func TestDiscussionsService_AddCommitDiscussionNote(t *testing.T) {
 	mux, client := setup(t)
 
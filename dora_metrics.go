@@ -17,6 +17,7 @@
 package gitlab
 
 import (
+	"errors"
 	"fmt"
 	"net/http"
 )
@@ -59,6 +60,23 @@ type GetDORAMetricsOptions struct {
 	EnvironmentTier *string `url:"environment_tier,omitempty" json:"environment_tier,omitempty"`
 }
 
+// validate checks that a known DORA metric is requested, so invalid requests
+// fail before hitting the network.
+func (opt GetDORAMetricsOptions) validate() error {
+	if opt.Metric == nil {
+		return errors.New("metric is required to get DORA metrics")
+	}
+	switch *opt.Metric {
+	case DORAMetricDeploymentFrequency,
+		DORAMetricLeadTimeForChanges,
+		DORAMetricTimeToRestoreService,
+		DORAMetricChangeFailureRate:
+		return nil
+	default:
+		return fmt.Errorf("unknown DORA metric %q", *opt.Metric)
+	}
+}
+
 // GetProjectDORAMetrics gets the DORA metrics for a project.
 //
 // GitLab API Docs:
@@ -68,6 +86,9 @@ func (s *DORAMetricsService) GetProjectDORAMetrics(pid interface{}, opt GetDORAM
 	if err != nil {
 		return nil, nil, err
 	}
+	if err := opt.validate(); err != nil {
+		return nil, nil, err
+	}
 	u := fmt.Sprintf("projects/%s/dora/metrics", PathEscape(project))
 
 	req, err := s.client.NewRequest(http.MethodGet, u, opt, options)
@@ -93,6 +114,9 @@ func (s *DORAMetricsService) GetGroupDORAMetrics(gid interface{}, opt GetDORAMet
 	if err != nil {
 		return nil, nil, err
 	}
+	if err := opt.validate(); err != nil {
+		return nil, nil, err
+	}
 	u := fmt.Sprintf("groups/%s/dora/metrics", PathEscape(group))
 
 	req, err := s.client.NewRequest(http.MethodGet, u, opt, options)
@@ -116,3 +116,21 @@ func TestDORAMetrics_GetGroupDORAMetrics(t *testing.T) {
 	require.NotNil(t, resp)
 	require.Equal(t, want, d)
 }
+
+func TestDORAMetrics_InvalidMetric(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/1/dora/metrics", func(w http.ResponseWriter, r *http.Request) {
+		t.Error("DORAMetrics.GetProjectDORAMetrics should not hit the network for an invalid metric")
+	})
+
+	_, resp, err := client.DORAMetrics.GetProjectDORAMetrics(1, GetDORAMetricsOptions{
+		Metric: Ptr(DORAMetricType("deployment_speed")),
+	})
+	require.EqualError(t, err, `unknown DORA metric "deployment_speed"`)
+	require.Nil(t, resp)
+
+	_, resp, err = client.DORAMetrics.GetGroupDORAMetrics(1, GetDORAMetricsOptions{})
+	require.EqualError(t, err, "metric is required to get DORA metrics")
+	require.Nil(t, resp)
+}
@@ -248,3 +248,46 @@ func (s *EnvironmentsService) StopEnvironment(pid interface{}, environmentID int
 
 	return env, resp, nil
 }
+
+// ListEnvironmentDeployments gets a list of the deployments of a single
+// environment, for example to pick a prior successful deployment to roll
+// back to. The Environment field of opt is set to the name of the
+// environment.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/deployments.html#list-project-deployments
+func (s *EnvironmentsService) ListEnvironmentDeployments(pid interface{}, environment int, opt *ListProjectDeploymentsOptions, options ...RequestOptionFunc) ([]*Deployment, *Response, error) {
+	env, resp, err := s.GetEnvironment(pid, environment, options...)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	opts := new(ListProjectDeploymentsOptions)
+	if opt != nil {
+		*opts = *opt
+	}
+	opts.Environment = Ptr(env.Name)
+
+	return s.client.Deployments.ListProjectDeployments(pid, opts, options...)
+}
+
+// RollbackEnvironment rolls an environment back to a prior deployment, by
+// retrying the job that created that deployment. Only successful deployments
+// can be rolled back to.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/ci/environments/index.html#retry-or-roll-back-a-deployment
+func (s *EnvironmentsService) RollbackEnvironment(pid interface{}, deployment int, options ...RequestOptionFunc) (*Job, *Response, error) {
+	d, resp, err := s.client.Deployments.GetProjectDeployment(pid, deployment, options...)
+	if err != nil {
+		return nil, resp, err
+	}
+	if d.Status != "success" {
+		return nil, resp, fmt.Errorf("cannot roll back to deployment %d with status %q", deployment, d.Status)
+	}
+	if d.Deployable.ID == 0 {
+		return nil, resp, fmt.Errorf("cannot roll back to deployment %d without a deployable job", deployment)
+	}
+
+	return s.client.Jobs.RetryJob(pid, d.Deployable.ID, options...)
+}
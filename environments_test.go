@@ -360,6 +360,87 @@ func TestStopEnvironment(t *testing.T) {
 	}
 }
 
+func TestStopEnvironmentForce(t *testing.T) {
+	mux, client := setup(t)
+
+	var wantBody string
+	mux.HandleFunc("/api/v4/projects/1/environments/1/stop", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testBody(t, r, wantBody)
+		fmt.Fprint(w, `{"id": 1, "name": "staging", "state": "stopped"}`)
+	})
+
+	wantBody = "null"
+	env, _, err := client.Environments.StopEnvironment(1, 1, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, "stopped", env.State)
+
+	wantBody = `{"force":true}`
+	_, _, err = client.Environments.StopEnvironment(1, 1, &StopEnvironmentOptions{Force: Ptr(true)})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestListEnvironmentDeployments(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/1/environments/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{"id": 1, "name": "review/fix-foo"}`)
+	})
+	mux.HandleFunc("/api/v4/projects/1/deployments", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testParams(t, r, "environment=review%2Ffix-foo&status=success")
+		fmt.Fprint(w, `[{"id": 42, "status": "success"}]`)
+	})
+
+	deployments, _, err := client.Environments.ListEnvironmentDeployments(1, 1, &ListProjectDeploymentsOptions{
+		Status: Ptr("success"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []*Deployment{{ID: 42, Status: "success"}}
+	assert.Equal(t, want, deployments)
+}
+
+func TestRollbackEnvironment(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/1/deployments/42", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{"id": 42, "status": "success", "deployable": {"id": 7}}`)
+	})
+	mux.HandleFunc("/api/v4/projects/1/deployments/43", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{"id": 43, "status": "failed", "deployable": {"id": 8}}`)
+	})
+	mux.HandleFunc("/api/v4/projects/1/deployments/44", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{"id": 44, "status": "success"}`)
+	})
+	mux.HandleFunc("/api/v4/projects/1/jobs/7/retry", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		fmt.Fprint(w, `{"id": 9, "status": "pending"}`)
+	})
+
+	job, _, err := client.Environments.RollbackEnvironment(1, 42)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, 9, job.ID)
+
+	_, _, err = client.Environments.RollbackEnvironment(1, 43)
+	assert.EqualError(t, err, `cannot roll back to deployment 43 with status "failed"`)
+
+	_, _, err = client.Environments.RollbackEnvironment(1, 44)
+	assert.EqualError(t, err, "cannot roll back to deployment 44 without a deployable job")
+}
+
 func TestUnmarshal(t *testing.T) {
 	jsonObject := `
     {
@@ -590,11 +590,11 @@ type MergeCommentEvent struct {
 				Email string `json:"email"`
 			} `json:"author"`
 		} `json:"last_commit"`
-		WorkInProgress      bool       `json:"work_in_progress"`
-		TotalTimeSpent      int        `json:"total_time_spent"`
-		HeadPipelineID      int        `json:"head_pipeline_id"`
-		Assignee            *EventUser `json:"assignee"`
-		DetailedMergeStatus string     `json:"detailed_merge_status"`
+		WorkInProgress      bool                     `json:"work_in_progress"`
+		TotalTimeSpent      int                      `json:"total_time_spent"`
+		HeadPipelineID      int                      `json:"head_pipeline_id"`
+		Assignee            *EventUser               `json:"assignee"`
+		DetailedMergeStatus DetailedMergeStatusValue `json:"detailed_merge_status"`
 	} `json:"merge_request"`
 }
 
@@ -675,20 +675,20 @@ type MergeEvent struct {
 				Email string `json:"email"`
 			} `json:"author"`
 		} `json:"last_commit"`
-		BlockingDiscussionsResolved bool          `json:"blocking_discussions_resolved"`
-		WorkInProgress              bool          `json:"work_in_progress"`
-		Draft                       bool          `json:"draft"`
-		TotalTimeSpent              int           `json:"total_time_spent"`
-		TimeChange                  int           `json:"time_change"`
-		HumanTotalTimeSpent         string        `json:"human_total_time_spent"`
-		HumanTimeChange             string        `json:"human_time_change"`
-		HumanTimeEstimate           string        `json:"human_time_estimate"`
-		FirstContribution           bool          `json:"first_contribution"`
-		URL                         string        `json:"url"`
-		Labels                      []*EventLabel `json:"labels"`
-		Action                      string        `json:"action"`
-		DetailedMergeStatus         string        `json:"detailed_merge_status"`
-		OldRev                      string        `json:"oldrev"`
+		BlockingDiscussionsResolved bool                     `json:"blocking_discussions_resolved"`
+		WorkInProgress              bool                     `json:"work_in_progress"`
+		Draft                       bool                     `json:"draft"`
+		TotalTimeSpent              int                      `json:"total_time_spent"`
+		TimeChange                  int                      `json:"time_change"`
+		HumanTotalTimeSpent         string                   `json:"human_total_time_spent"`
+		HumanTimeChange             string                   `json:"human_time_change"`
+		HumanTimeEstimate           string                   `json:"human_time_estimate"`
+		FirstContribution           bool                     `json:"first_contribution"`
+		URL                         string                   `json:"url"`
+		Labels                      []*EventLabel            `json:"labels"`
+		Action                      string                   `json:"action"`
+		DetailedMergeStatus         DetailedMergeStatusValue `json:"detailed_merge_status"`
+		OldRev                      string                   `json:"oldrev"`
 	} `json:"object_attributes"`
 	Repository *Repository   `json:"repository"`
 	Labels     []*EventLabel `json:"labels"`
@@ -845,17 +845,17 @@ type PipelineEvent struct {
 		} `json:"variables"`
 	} `json:"object_attributes"`
 	MergeRequest struct {
-		ID                  int    `json:"id"`
-		IID                 int    `json:"iid"`
-		Title               string `json:"title"`
-		SourceBranch        string `json:"source_branch"`
-		SourceProjectID     int    `json:"source_project_id"`
-		TargetBranch        string `json:"target_branch"`
-		TargetProjectID     int    `json:"target_project_id"`
-		State               string `json:"state"`
-		MergeRequestStatus  string `json:"merge_status"`
-		DetailedMergeStatus string `json:"detailed_merge_status"`
-		URL                 string `json:"url"`
+		ID                  int                      `json:"id"`
+		IID                 int                      `json:"iid"`
+		Title               string                   `json:"title"`
+		SourceBranch        string                   `json:"source_branch"`
+		SourceProjectID     int                      `json:"source_project_id"`
+		TargetBranch        string                   `json:"target_branch"`
+		TargetProjectID     int                      `json:"target_project_id"`
+		State               string                   `json:"state"`
+		MergeRequestStatus  string                   `json:"merge_status"`
+		DetailedMergeStatus DetailedMergeStatusValue `json:"detailed_merge_status"`
+		URL                 string                   `json:"url"`
 	} `json:"merge_request"`
 	User    *EventUser `json:"user"`
 	Project struct {
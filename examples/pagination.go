@@ -17,6 +17,7 @@
 package main
 
 import (
+	"context"
 	"log"
 
 	"github.com/xanzy/go-gitlab"
@@ -99,3 +100,29 @@ func keysetPagination() {
 		}
 	}
 }
+
+func streamPagination() {
+	git, err := gitlab.NewClient("yourtokengoeshere")
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// Walk all pages of pipelines in the background.
+	pipelines, errs := gitlab.StreamChan(ctx, func(lo gitlab.ListOptions) ([]*gitlab.PipelineInfo, *gitlab.Response, error) {
+		opt := &gitlab.ListProjectPipelinesOptions{ListOptions: lo}
+		return git.Pipelines.ListProjectPipelines(2743054, opt, gitlab.WithContext(ctx))
+	})
+
+	// Handle the pipelines as they come in.
+	for p := range pipelines {
+		log.Printf("Found pipeline: %d (%s)", p.ID, p.Status)
+	}
+
+	// The error channel yields nil when all pages were read.
+	if err := <-errs; err != nil {
+		log.Fatal(err)
+	}
+}
@@ -0,0 +1,63 @@
+//
+// Copyright 2021, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+
+	"github.com/xanzy/go-gitlab"
+)
+
+// spanContext is a stand-in for the span context of a tracing library, for
+// example trace.SpanContextFromContext when using OpenTelemetry.
+type spanContext struct {
+	TraceID string
+	SpanID  string
+}
+
+type spanContextKey struct{}
+
+func requestInterceptor() {
+	// Inject a W3C traceparent header into every request, using the span
+	// context stored in the request context.
+	interceptor := func(req *http.Request) {
+		sc, ok := req.Context().Value(spanContextKey{}).(spanContext)
+		if !ok {
+			return
+		}
+		req.Header.Set("traceparent", fmt.Sprintf("00-%s-%s-01", sc.TraceID, sc.SpanID))
+	}
+
+	git, err := gitlab.NewClient("yourtokengoeshere", gitlab.WithRequestInterceptor(interceptor))
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	ctx := context.WithValue(context.Background(), spanContextKey{}, spanContext{
+		TraceID: "4bf92f3577b34da6a3ce929d0e0e4736",
+		SpanID:  "00f067aa0ba902b7",
+	})
+
+	user, _, err := git.Users.CurrentUser(gitlab.WithContext(ctx))
+	if err != nil {
+		log.Fatal(err)
+	}
+	log.Printf("Current user: %s", user.Username)
+}
@@ -88,7 +88,11 @@ type PublishPackageFileOptions struct {
 	Select *GenericPackageSelectValue `url:"select,omitempty" json:"select,omitempty"`
 }
 
-// PublishPackageFile uploads a file to a project's package registry.
+// PublishPackageFile uploads a file to a project's package registry. The file
+// is sent as application/octet-stream, unless a Content-Type header is set
+// using a request option. GitLab only returns the details of the published
+// file (with a 200 OK) when Select is set to package_file; otherwise it
+// replies 201 Created and the returned file is empty.
 //
 // GitLab docs:
 // https://docs.gitlab.com/ee/user/packages/generic_packages/index.html#publish-a-package-file
@@ -116,6 +120,9 @@ func (s *GenericPackagesService) PublishPackageFile(pid interface{}, packageName
 	// Overwrite the method and body.
 	req.Method = http.MethodPut
 	req.SetBody(content)
+	if req.Header.Get("Content-Type") == "" {
+		req.Header.Set("Content-Type", "application/octet-stream")
+	}
 
 	f := new(GenericPackagesFile)
 	resp, err := s.client.Do(req, f)
@@ -156,3 +163,29 @@ func (s *GenericPackagesService) DownloadPackageFile(pid interface{}, packageNam
 
 	return f.Bytes(), resp, err
 }
+
+// DownloadPackageFileStream allows you to download the package file without
+// reading it into memory. The content type of the file is available in the
+// headers of the returned response. The caller must close the returned reader.
+//
+// GitLab docs:
+// https://docs.gitlab.com/ee/user/packages/generic_packages/index.html#download-package-file
+func (s *GenericPackagesService) DownloadPackageFileStream(pid interface{}, packageName, packageVersion, fileName string, options ...RequestOptionFunc) (io.ReadCloser, *Response, error) {
+	u, err := s.FormatPackageURL(pid, packageName, packageVersion, fileName)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest(http.MethodGet, u, nil, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	stream := new(responseStream)
+	resp, err := s.client.Do(req, stream)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return stream, resp, nil
+}
@@ -17,11 +17,14 @@
 package gitlab
 
 import (
+	"errors"
 	"fmt"
+	"io"
 	"net/http"
 	"reflect"
 	"strings"
 	"testing"
+	"time"
 )
 
 func TestPublishPackageFile(t *testing.T) {
@@ -62,3 +65,72 @@ func TestDownloadPackageFile(t *testing.T) {
 		t.Errorf("GenericPackages.DownloadPackageFile returned %+v, want %+v", packageBytes, want)
 	}
 }
+
+func TestPublishPackageFileSelect(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/1234/packages/generic/foo/0.1.2/bar-baz.txt", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPut)
+		testParams(t, r, "select=package_file&status=hidden")
+		testBody(t, r, "bar = baz")
+		if got := r.Header.Get("Content-Type"); got != "application/octet-stream" {
+			t.Errorf("Content-Type header is %q, want %q", got, "application/octet-stream")
+		}
+		fmt.Fprint(w, `{"id":1,"package_id":2,"file_name":"bar-baz.txt","size":9}`)
+	})
+
+	opt := &PublishPackageFileOptions{
+		Status: Ptr(PackageHidden),
+		Select: Ptr(SelectPackageFile),
+	}
+	file, resp, err := client.GenericPackages.PublishPackageFile(1234, "foo", "0.1.2", "bar-baz.txt", strings.NewReader("bar = baz"), opt)
+	if err != nil {
+		t.Fatalf("GenericPackages.PublishPackageFile returned error: %v", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		t.Errorf("GenericPackages.PublishPackageFile returned status %d, want %d", resp.StatusCode, http.StatusOK)
+	}
+	if file.ID != 1 || file.PackageID != 2 || file.FileName != "bar-baz.txt" {
+		t.Errorf("GenericPackages.PublishPackageFile returned %+v", file)
+	}
+}
+
+func TestDownloadPackageFileStream(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/1234/packages/generic/foo/0.1.2/bar-baz.txt", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		w.Header().Set("Content-Type", "text/plain")
+		fmt.Fprint(w, "bar = baz")
+	})
+
+	stream, resp, err := client.GenericPackages.DownloadPackageFileStream(1234, "foo", "0.1.2", "bar-baz.txt", WithRequestTimeout(time.Minute))
+	if err != nil {
+		t.Fatalf("GenericPackages.DownloadPackageFileStream returned error: %v", err)
+	}
+	defer stream.Close()
+
+	if got := resp.Header.Get("Content-Type"); got != "text/plain" {
+		t.Errorf("Content-Type header is %q, want %q", got, "text/plain")
+	}
+
+	content, err := io.ReadAll(stream)
+	if err != nil {
+		t.Fatalf("reading the package file returned error: %v", err)
+	}
+	if string(content) != "bar = baz" {
+		t.Errorf("GenericPackages.DownloadPackageFileStream returned %q, want %q", content, "bar = baz")
+	}
+}
+
+func TestDownloadPackageFileStreamNotFound(t *testing.T) {
+	_, client := setup(t)
+
+	stream, _, err := client.GenericPackages.DownloadPackageFileStream(1234, "foo", "0.1.2", "missing.txt")
+	if !errors.Is(err, ErrNotFound) {
+		t.Errorf("GenericPackages.DownloadPackageFileStream returned error %v, want %v", err, ErrNotFound)
+	}
+	if stream != nil {
+		t.Errorf("GenericPackages.DownloadPackageFileStream returned a stream on error")
+	}
+}
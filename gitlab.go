@@ -29,6 +29,7 @@ import (
 	"mime/multipart"
 	"net/http"
 	"net/url"
+	"runtime/debug"
 	"sort"
 	"strconv"
 	"strings"
@@ -47,6 +48,7 @@ const (
 	defaultBaseURL = "https://gitlab.com/"
 	apiVersionPath = "api/v4/"
 	userAgent      = "go-gitlab"
+	modulePath     = "github.com/xanzy/go-gitlab"
 
 	headerRateLimit = "RateLimit-Limit"
 	headerRateReset = "RateLimit-Reset"
@@ -109,10 +111,24 @@ type Client struct {
 	// Default request options applied to every request.
 	defaultRequestOptions []RequestOptionFunc
 
+	// Header used to send the correlation ID set with WithCorrelationID.
+	correlationIDHeader string
+
+	// Unmarshaler used to decode response bodies. When nil, responses are
+	// decoded with encoding/json.
+	unmarshaler func([]byte, interface{}) error
+
+	// Interceptor invoked on every outgoing request just before it is sent.
+	requestInterceptor func(*http.Request)
+
 	// User agent used when communicating with the GitLab API.
 	UserAgent string
 
+	// Client name sent in the X-Gitlab-Client-Name header, if set.
+	clientName string
+
 	// Services used for talking to different parts of the GitLab API.
+	AbuseReports                 *AbuseReportsService
 	AccessRequests               *AccessRequestsService
 	Appearance                   *AppearanceService
 	Applications                 *ApplicationsService
@@ -335,7 +351,7 @@ func NewOAuthClient(token string, options ...ClientOptionFunc) (*Client, error)
 }
 
 func newClient(options ...ClientOptionFunc) (*Client, error) {
-	c := &Client{UserAgent: userAgent}
+	c := &Client{UserAgent: defaultUserAgent()}
 
 	// Configure the HTTP client.
 	c.client = &retryablehttp.Client{
@@ -373,6 +389,7 @@ func newClient(options ...ClientOptionFunc) (*Client, error) {
 	timeStats := &timeStatsService{client: c}
 
 	// Create all the public services.
+	c.AbuseReports = &AbuseReportsService{client: c}
 	c.AccessRequests = &AccessRequestsService{client: c}
 	c.Appearance = &AppearanceService{client: c}
 	c.Applications = &ApplicationsService{client: c}
@@ -597,6 +614,22 @@ func (c *Client) configureLimiter(ctx context.Context, headers http.Header) {
 	}
 }
 
+// defaultUserAgent returns the user agent used when none is configured, in
+// the form go-gitlab/<version>. The version is taken from the build info of
+// the binary and falls back to "devel" when it cannot be determined.
+func defaultUserAgent() string {
+	version := "devel"
+	if bi, ok := debug.ReadBuildInfo(); ok {
+		for _, m := range append([]*debug.Module{&bi.Main}, bi.Deps...) {
+			if m.Path == modulePath && m.Version != "" && m.Version != "(devel)" {
+				version = m.Version
+				break
+			}
+		}
+	}
+	return userAgent + "/" + version
+}
+
 // BaseURL return a copy of the baseURL.
 func (c *Client) BaseURL() *url.URL {
 	u := *c.baseURL
@@ -648,6 +681,9 @@ func (c *Client) NewRequest(method, path string, opt interface{}, options []Requ
 	if c.UserAgent != "" {
 		reqHeaders.Set("User-Agent", c.UserAgent)
 	}
+	if c.clientName != "" {
+		reqHeaders.Set("X-Gitlab-Client-Name", c.clientName)
+	}
 
 	var body interface{}
 	switch {
@@ -681,6 +717,7 @@ func (c *Client) NewRequest(method, path string, opt interface{}, options []Requ
 			return nil, err
 		}
 	}
+	c.setCorrelationIDHeader(req)
 
 	// Set the request specific headers.
 	for k, v := range reqHeaders {
@@ -690,6 +727,18 @@ func (c *Client) NewRequest(method, path string, opt interface{}, options []Requ
 	return req, nil
 }
 
+// setCorrelationIDHeader moves a correlation ID set with WithCorrelationID to
+// the header configured with WithCorrelationIDHeader.
+func (c *Client) setCorrelationIDHeader(req *retryablehttp.Request) {
+	if c.correlationIDHeader == "" || c.correlationIDHeader == xRequestID {
+		return
+	}
+	if id := req.Header.Get(xRequestID); id != "" {
+		req.Header.Del(xRequestID)
+		req.Header.Set(c.correlationIDHeader, id)
+	}
+}
+
 // UploadRequest creates an API request for uploading a file. The method
 // expects a relative URL path that will be resolved relative to the base
 // URL of the Client. Relative URL paths should always be specified without
@@ -713,6 +762,9 @@ func (c *Client) UploadRequest(method, path string, content io.Reader, filename
 	if c.UserAgent != "" {
 		reqHeaders.Set("User-Agent", c.UserAgent)
 	}
+	if c.clientName != "" {
+		reqHeaders.Set("X-Gitlab-Client-Name", c.clientName)
+	}
 
 	b := new(bytes.Buffer)
 	w := multipart.NewWriter(b)
@@ -757,6 +809,7 @@ func (c *Client) UploadRequest(method, path string, content io.Reader, filename
 			return nil, err
 		}
 	}
+	c.setCorrelationIDHeader(req)
 
 	// Set the request specific headers.
 	for k, v := range reqHeaders {
@@ -785,17 +838,25 @@ type Response struct {
 	NextLink     string
 	FirstLink    string
 	LastLink     string
+
+	// CorrelationID is the ID GitLab assigned to the request, as returned in
+	// the X-Request-Id header. It is logged by GitLab and can be used to
+	// find the request in its logs.
+	CorrelationID string
 }
 
 // newResponse creates a new Response for the provided http.Response.
 func newResponse(r *http.Response) *Response {
-	response := &Response{Response: r}
+	response := &Response{Response: r, CorrelationID: r.Header.Get(xRequestID)}
 	response.populatePageValues()
 	response.populateLinkValues()
 	return response
 }
 
 const (
+	// Header holding the correlation ID of a request.
+	xRequestID = "X-Request-Id"
+
 	// Headers used for offset-based pagination.
 	xTotal      = "X-Total"
 	xTotalPages = "X-Total-Pages"
@@ -859,12 +920,44 @@ func (r *Response) populateLinkValues() {
 	}
 }
 
+// LastPage returns the page number of the rel="last" Link header. This can be
+// used to estimate the total number of pages when the X-Total-Pages header is
+// absent. It returns false when there is no such link or it holds no page.
+func (r *Response) LastPage() (int, bool) {
+	if r.LastLink == "" {
+		return 0, false
+	}
+
+	u, err := url.Parse(r.LastLink)
+	if err != nil {
+		return 0, false
+	}
+
+	page, err := strconv.Atoi(u.Query().Get("page"))
+	if err != nil || page < 1 {
+		return 0, false
+	}
+
+	return page, true
+}
+
 // Do sends an API request and returns the API response. The API response is
 // JSON decoded and stored in the value pointed to by v, or returned as an
 // error if an API error has occurred. If v implements the io.Writer
 // interface, the raw response body will be written to v, without attempting to
 // first decode it.
 func (c *Client) Do(req *retryablehttp.Request, v interface{}) (*Response, error) {
+	// Release the context derived by WithRequestTimeout, if any. When the
+	// response body is handed over to a responseStream, releasing it is left
+	// to the stream.
+	cancel, _ := req.Context().Value(requestCancelKey{}).(context.CancelFunc)
+	stream, streaming := v.(*responseStream)
+	defer func() {
+		if cancel != nil && (!streaming || stream.ReadCloser == nil) {
+			cancel()
+		}
+	}()
+
 	// Wait will block until the limiter can obtain a new token.
 	err := c.limiter.Wait(req.Context())
 	if err != nil {
@@ -901,6 +994,10 @@ func (c *Client) Do(req *retryablehttp.Request, v interface{}) (*Response, error
 		}
 	}
 
+	if c.requestInterceptor != nil {
+		c.interceptRequest(req.Request)
+	}
+
 	resp, err := c.client.Do(req)
 	if err != nil {
 		return nil, err
@@ -914,8 +1011,6 @@ func (c *Client) Do(req *retryablehttp.Request, v interface{}) (*Response, error
 		}
 		return c.Do(req, v)
 	}
-	defer resp.Body.Close()
-	defer io.Copy(io.Discard, resp.Body)
 
 	// If not yet configured, try to configure the rate limiter
 	// using the response headers we just received. Fail silently
@@ -925,6 +1020,15 @@ func (c *Client) Do(req *retryablehttp.Request, v interface{}) (*Response, error
 	response := newResponse(resp)
 
 	err = CheckResponse(resp)
+	if err == nil && streaming {
+		stream.ReadCloser = resp.Body
+		stream.cancel = cancel
+		return response, nil
+	}
+
+	defer resp.Body.Close()
+	defer io.Copy(io.Discard, resp.Body)
+
 	if err != nil {
 		// Even though there was an error, we still return the response
 		// in case the caller wants to inspect it further.
@@ -935,13 +1039,68 @@ func (c *Client) Do(req *retryablehttp.Request, v interface{}) (*Response, error
 		if w, ok := v.(io.Writer); ok {
 			_, err = io.Copy(w, resp.Body)
 		} else {
-			err = json.NewDecoder(resp.Body).Decode(v)
+			err = c.decode(resp.Body, v)
 		}
 	}
 
 	return response, err
 }
 
+// authHeaders are the headers used to authenticate a request, which a request
+// interceptor is not allowed to change.
+var authHeaders = []string{"Authorization", "JOB-TOKEN", "PRIVATE-TOKEN"}
+
+// interceptRequest runs the configured request interceptor and afterwards
+// restores the authentication headers, so the interceptor cannot add, remove
+// or replace the credentials set by the client.
+func (c *Client) interceptRequest(req *http.Request) {
+	saved := make(map[string][]string, len(authHeaders))
+	for _, h := range authHeaders {
+		if values := req.Header.Values(h); len(values) > 0 {
+			saved[h] = append([]string(nil), values...)
+		}
+	}
+
+	c.requestInterceptor(req)
+
+	for _, h := range authHeaders {
+		if values, ok := saved[h]; ok {
+			req.Header[http.CanonicalHeaderKey(h)] = values
+		} else {
+			req.Header.Del(h)
+		}
+	}
+}
+
+// decode decodes a response body into v, using the custom unmarshaler when one
+// is configured.
+func (c *Client) decode(body io.Reader, v interface{}) error {
+	if c.unmarshaler == nil {
+		return json.NewDecoder(body).Decode(v)
+	}
+	data, err := io.ReadAll(body)
+	if err != nil {
+		return err
+	}
+	return c.unmarshaler(data, v)
+}
+
+// responseStream can be passed to Client.Do to take ownership of the body of
+// a successful response, instead of having it decoded or copied. The caller
+// must close the stream.
+type responseStream struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (s *responseStream) Close() error {
+	err := s.ReadCloser.Close()
+	if s.cancel != nil {
+		s.cancel()
+	}
+	return err
+}
+
 func (c *Client) requestOAuthToken(ctx context.Context, token string) (string, error) {
 	c.tokenLock.Lock()
 	defer c.tokenLock.Unlock()
@@ -986,6 +1145,54 @@ func PathEscape(s string) string {
 	return strings.ReplaceAll(url.PathEscape(s), ".", "%2E")
 }
 
+// EncodeProjectPath joins the given namespace and project names into a full
+// project path and escapes it for use in a request path, encoding the slashes
+// between the segments as %2F. Leading and trailing slashes of the segments
+// and empty segments are ignored, so EncodeProjectPath("group", "subgroup",
+// "project") returns "group%2Fsubgroup%2Fproject".
+//
+// Use it when building request paths for Client.NewRequest. Service methods
+// escape the pid and gid they are given themselves, so pass them the plain
+// path ("group/subgroup/project") instead, or the path would be escaped twice.
+func EncodeProjectPath(parts ...string) string {
+	return encodePath(parts)
+}
+
+// EncodeGroupPath joins the given group names into a full group path and
+// escapes it for use in a request path, in the same way as EncodeProjectPath.
+func EncodeGroupPath(parts ...string) string {
+	return encodePath(parts)
+}
+
+func encodePath(parts []string) string {
+	segments := make([]string, 0, len(parts))
+	for _, p := range parts {
+		if p = strings.Trim(p, "/"); p != "" {
+			segments = append(segments, p)
+		}
+	}
+	return PathEscape(strings.Join(segments, "/"))
+}
+
+// Helper function to call fn for every index in [0, n) using at most the
+// given number of concurrent workers. It returns once all calls are done.
+func forEachBounded(n, workers int, fn func(i int)) {
+	sem := make(chan struct{}, workers)
+	var wg sync.WaitGroup
+	for i := 0; i < n; i++ {
+		sem <- struct{}{}
+		wg.Add(1)
+		go func(i int) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			fn(i)
+		}(i)
+	}
+	wg.Wait()
+}
+
 // An ErrorResponse reports one or more errors caused by an API request.
 //
 // GitLab API docs:
@@ -1007,6 +1214,118 @@ func (e *ErrorResponse) Error() string {
 	}
 }
 
+// FieldErrors returns the validation errors reported in the body of the
+// response, keyed by the attribute they apply to. Errors of nested entities
+// are keyed by their dotted path, for example "namespace.path", and errors
+// that do not apply to an attribute are keyed by "base". It returns nil when
+// the body holds no error messages.
+func (e *ErrorResponse) FieldErrors() map[string][]string {
+	var body struct {
+		Message ErrorMessage `json:"message"`
+		Error   ErrorMessage `json:"error"`
+	}
+	if err := json.Unmarshal(e.Body, &body); err != nil {
+		return nil
+	}
+
+	errs := body.Message
+	for k, msgs := range body.Error {
+		if errs == nil {
+			errs = make(ErrorMessage)
+		}
+		errs[k] = append(errs[k], msgs...)
+	}
+	if len(errs) == 0 {
+		return nil
+	}
+
+	return errs
+}
+
+// ErrorMessage represents an error message returned by GitLab. Depending on
+// the endpoint, GitLab returns a string, a list of strings or an object with
+// the errors of every attribute, possibly nested. All of them are normalized
+// into a flat map, in the same way as ErrorResponse.FieldErrors.
+type ErrorMessage map[string][]string
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (m *ErrorMessage) UnmarshalJSON(data []byte) error {
+	var raw interface{}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	msg := make(ErrorMessage)
+	msg.add("", raw)
+	if len(msg) == 0 {
+		msg = nil
+	}
+	*m = msg
+
+	return nil
+}
+
+func (m ErrorMessage) add(key string, raw interface{}) {
+	switch raw := raw.(type) {
+	case nil:
+	case []interface{}:
+		for _, v := range raw {
+			m.add(key, v)
+		}
+	case map[string]interface{}:
+		for k, v := range raw {
+			if key != "" {
+				k = key + "." + k
+			}
+			m.add(k, v)
+		}
+	default:
+		if key == "" {
+			key = "base"
+		}
+		m[key] = append(m[key], fmt.Sprint(raw))
+	}
+}
+
+// String renders the messages sorted by attribute, for example
+// "base: is invalid; name: has already been taken, is too short".
+func (m ErrorMessage) String() string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	parts := make([]string, 0, len(keys))
+	for _, k := range keys {
+		parts = append(parts, fmt.Sprintf("%s: %s", k, strings.Join(m[k], ", ")))
+	}
+
+	return strings.Join(parts, "; ")
+}
+
+// sentinelError wraps an API error so it matches a package level sentinel
+// error with errors.Is, while still unwrapping to the original error. The
+// exported Err* sentinels of this package are returned this way, so callers
+// can check for the sentinel with errors.Is and still use errors.As to get
+// the *ErrorResponse holding the status code and message returned by GitLab.
+type sentinelError struct {
+	sentinel error
+	err      error
+}
+
+func (e *sentinelError) Error() string {
+	return fmt.Sprintf("%v: %v", e.sentinel, e.err)
+}
+
+func (e *sentinelError) Is(target error) bool {
+	return target == e.sentinel
+}
+
+func (e *sentinelError) Unwrap() error {
+	return e.err
+}
+
 // CheckResponse checks the API response for errors, and returns them if present.
 func CheckResponse(r *http.Response) error {
 	switch r.StatusCode {
@@ -19,16 +19,19 @@ package gitlab
 import (
 	"bytes"
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
 	"net/http"
 	"net/http/httptest"
 	"os"
+	"reflect"
 	"strings"
 	"testing"
 	"time"
 
+	"github.com/google/go-querystring/query"
 	retryablehttp "github.com/hashicorp/go-retryablehttp"
 )
 
@@ -116,8 +119,181 @@ func TestNewClient(t *testing.T) {
 	if c.BaseURL().String() != expectedBaseURL {
 		t.Errorf("NewClient BaseURL is %s, want %s", c.BaseURL().String(), expectedBaseURL)
 	}
-	if c.UserAgent != userAgent {
-		t.Errorf("NewClient UserAgent is %s, want %s", c.UserAgent, userAgent)
+	if !strings.HasPrefix(c.UserAgent, userAgent+"/") {
+		t.Errorf("NewClient UserAgent is %s, want prefix %s/", c.UserAgent, userAgent)
+	}
+}
+
+func TestBaseURLWithPathPrefix(t *testing.T) {
+	for _, baseURL := range []string{
+		"https://example.com/gitlab",
+		"https://example.com/gitlab/",
+		"https://example.com/gitlab/api/v4",
+		"https://example.com/gitlab/api/v4/",
+	} {
+		c, err := NewClient("", WithBaseURL(baseURL))
+		if err != nil {
+			t.Fatalf("Failed to create client: %v", err)
+		}
+
+		if got, want := c.BaseURL().String(), "https://example.com/gitlab/api/v4/"; got != want {
+			t.Errorf("BaseURL for %s is %s, want %s", baseURL, got, want)
+		}
+
+		req, err := c.NewRequest(http.MethodGet, "projects/group%2Fproject/issues", nil, nil)
+		if err != nil {
+			t.Fatalf("NewRequest returned error: %v", err)
+		}
+		if got, want := req.URL.String(), "https://example.com/gitlab/api/v4/projects/group%2Fproject/issues"; got != want {
+			t.Errorf("Request URL for %s is %s, want %s", baseURL, got, want)
+		}
+	}
+}
+
+func TestBaseURLWithPathPrefixRequest(t *testing.T) {
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	mux.HandleFunc("/gitlab/api/v4/user", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"id":1}`)
+	})
+
+	client, err := NewClient("", WithBaseURL(server.URL+"/gitlab"))
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	if _, _, err := client.Users.CurrentUser(); err != nil {
+		t.Fatalf("Users.CurrentUser returned error: %v", err)
+	}
+}
+
+func TestUserAgent(t *testing.T) {
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	mux.HandleFunc("/api/v4/user", func(w http.ResponseWriter, r *http.Request) {
+		if got, want := r.Header.Get("User-Agent"), "my-tool/1.0"; got != want {
+			t.Errorf("User-Agent header is %q, want %q", got, want)
+		}
+		if got, want := r.Header.Get("X-Gitlab-Client-Name"), "my-tool"; got != want {
+			t.Errorf("X-Gitlab-Client-Name header is %q, want %q", got, want)
+		}
+		fmt.Fprint(w, `{"id":1}`)
+	})
+
+	client, err := NewClient("",
+		WithBaseURL(server.URL),
+		WithUserAgent("my-tool/1.0"),
+		WithClientName("my-tool"),
+	)
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	if _, _, err := client.Users.CurrentUser(); err != nil {
+		t.Fatalf("Users.CurrentUser returned error: %v", err)
+	}
+}
+
+func TestDefaultUserAgentSent(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/user", func(w http.ResponseWriter, r *http.Request) {
+		if got := r.Header.Get("User-Agent"); !strings.HasPrefix(got, userAgent+"/") {
+			t.Errorf("User-Agent header is %q, want prefix %s/", got, userAgent)
+		}
+		if got := r.Header.Get("X-Gitlab-Client-Name"); got != "" {
+			t.Errorf("X-Gitlab-Client-Name header is %q, want it unset", got)
+		}
+		fmt.Fprint(w, `{"id":1}`)
+	})
+
+	if _, _, err := client.Users.CurrentUser(); err != nil {
+		t.Fatalf("Users.CurrentUser returned error: %v", err)
+	}
+}
+
+func TestCustomUnmarshaler(t *testing.T) {
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	mux.HandleFunc("/api/v4/user", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"id":1,"username":"john_smith","created_at":"2012-05-23 08:00:58"}`)
+	})
+
+	var called bool
+	unmarshal := func(data []byte, v interface{}) error {
+		called = true
+		// Rewrite the timestamp into a format time.Time can parse.
+		data = bytes.Replace(data, []byte("2012-05-23 08:00:58"), []byte("2012-05-23T08:00:58Z"), 1)
+		return json.Unmarshal(data, v)
+	}
+
+	client, err := NewClient("",
+		WithBaseURL(server.URL),
+		WithCustomUnmarshaler(unmarshal),
+	)
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	user, _, err := client.Users.CurrentUser()
+	if err != nil {
+		t.Fatalf("Users.CurrentUser returned error: %v", err)
+	}
+	if !called {
+		t.Errorf("custom unmarshaler was not called")
+	}
+
+	want := time.Date(2012, 5, 23, 8, 0, 58, 0, time.UTC)
+	if user.Username != "john_smith" || user.CreatedAt == nil || !user.CreatedAt.Equal(want) {
+		t.Errorf("Users.CurrentUser returned %+v, want username john_smith created at %v", user, want)
+	}
+}
+
+func TestRequestInterceptor(t *testing.T) {
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	mux.HandleFunc("/api/v4/user", func(w http.ResponseWriter, r *http.Request) {
+		if got := r.Header.Get("traceparent"); got != "00-trace-span-01" {
+			t.Errorf("traceparent header = %q, want %q", got, "00-trace-span-01")
+		}
+		if got := r.Header.Get("PRIVATE-TOKEN"); got != "token" {
+			t.Errorf("PRIVATE-TOKEN header = %q, want %q", got, "token")
+		}
+		if got := r.Header.Get("Authorization"); got != "" {
+			t.Errorf("Authorization header = %q, want it unset", got)
+		}
+		fmt.Fprint(w, `{"id":1}`)
+	})
+
+	type traceKey struct{}
+	interceptor := func(req *http.Request) {
+		if tp, ok := req.Context().Value(traceKey{}).(string); ok {
+			req.Header.Set("traceparent", tp)
+		}
+		// Attempts to change the credentials must be discarded.
+		req.Header.Del("PRIVATE-TOKEN")
+		req.Header.Set("Authorization", "Bearer other")
+	}
+
+	client, err := NewClient("token",
+		WithBaseURL(server.URL),
+		WithRequestInterceptor(interceptor),
+	)
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	ctx := context.WithValue(context.Background(), traceKey{}, "00-trace-span-01")
+	if _, _, err := client.Users.CurrentUser(WithContext(ctx)); err != nil {
+		t.Fatalf("Users.CurrentUser returned error: %v", err)
 	}
 }
 
@@ -173,6 +349,79 @@ func TestCheckResponse(t *testing.T) {
 	}
 }
 
+func TestErrorResponseFieldErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		body string
+		want map[string][]string
+	}{
+		{
+			name: "string",
+			body: `{"message": "403 Forbidden"}`,
+			want: map[string][]string{"base": {"403 Forbidden"}},
+		},
+		{
+			name: "array",
+			body: `{"message": ["Branch already exists", "Ref is invalid"]}`,
+			want: map[string][]string{"base": {"Branch already exists", "Ref is invalid"}},
+		},
+		{
+			name: "object",
+			body: `{
+				"message": {
+					"base": ["is invalid"],
+					"name": ["has already been taken", "is too short"],
+					"namespace": {"path": ["can't be blank"]}
+				},
+				"error": "validation failed"
+			}`,
+			want: map[string][]string{
+				"base":           {"is invalid", "validation failed"},
+				"name":           {"has already been taken", "is too short"},
+				"namespace.path": {"can't be blank"},
+			},
+		},
+		{
+			name: "error",
+			body: `{"error": "insufficient_scope"}`,
+			want: map[string][]string{"base": {"insufficient_scope"}},
+		},
+		{
+			name: "empty",
+			body: `{"message": []}`,
+			want: nil,
+		},
+		{
+			name: "unknown format",
+			body: `not json`,
+			want: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := &ErrorResponse{Body: []byte(tt.body)}
+			got := e.FieldErrors()
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("FieldErrors returned %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestErrorMessageString(t *testing.T) {
+	var m ErrorMessage
+	err := json.Unmarshal([]byte(`{"name": ["has already been taken", "is too short"], "base": "is invalid"}`), &m)
+	if err != nil {
+		t.Fatalf("Failed to unmarshal error message: %v", err)
+	}
+
+	want := "base: is invalid; name: has already been taken, is too short"
+	if got := m.String(); got != want {
+		t.Errorf("ErrorMessage.String returned %q, want %q", got, want)
+	}
+}
+
 func TestCheckResponseOnUnknownErrorFormat(t *testing.T) {
 	c, err := NewClient("")
 	if err != nil {
@@ -267,6 +516,51 @@ func TestPathEscape(t *testing.T) {
 	}
 }
 
+func TestEncodeProjectPath(t *testing.T) {
+	tests := []struct {
+		parts []string
+		want  string
+	}{
+		{[]string{"diaspora", "diaspora"}, "diaspora%2Fdiaspora"},
+		{[]string{"group", "subgroup", "project"}, "group%2Fsubgroup%2Fproject"},
+		{[]string{"/group/", "", "project/"}, "group%2Fproject"},
+		{[]string{"group/subgroup", "project"}, "group%2Fsubgroup%2Fproject"},
+		{[]string{"my-group", "my_project.go"}, "my-group%2Fmy_project%2Ego"},
+		{[]string{"gruppe", "übersicht"}, "gruppe%2F%C3%BCbersicht"},
+		{[]string{"team space", "a+b"}, "team%20space%2Fa+b"},
+	}
+
+	for _, tt := range tests {
+		if got := EncodeProjectPath(tt.parts...); got != tt.want {
+			t.Errorf("EncodeProjectPath(%q) = %s, want %s", tt.parts, got, tt.want)
+		}
+	}
+
+	if got, want := EncodeGroupPath("group", "subgroup"), "group%2Fsubgroup"; got != want {
+		t.Errorf("EncodeGroupPath = %s, want %s", got, want)
+	}
+}
+
+func TestEncodeProjectPathInRequest(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		if got, want := r.URL.EscapedPath(), "/api/v4/projects/gruppe%2F%C3%BCbersicht%2Emain"; got != want {
+			t.Errorf("Request path: %s, want %s", got, want)
+		}
+		fmt.Fprint(w, `{"id":1}`)
+	})
+
+	req, err := client.NewRequest(http.MethodGet, "projects/"+EncodeProjectPath("gruppe", "übersicht.main"), nil, nil)
+	if err != nil {
+		t.Fatalf("NewRequest returned error: %v", err)
+	}
+	if _, err := client.Do(req, new(Project)); err != nil {
+		t.Fatalf("Do returned error: %v", err)
+	}
+}
+
 func TestPaginationPopulatePageValuesEmpty(t *testing.T) {
 	wantPageHeaders := map[string]int{
 		xTotal:      0,
@@ -373,6 +667,32 @@ func TestPaginationPopulatePageValuesOffset(t *testing.T) {
 	}
 }
 
+func TestResponseLastPage(t *testing.T) {
+	h := http.Header{}
+	h.Add("Link", strings.Join([]string{
+		`<https://gitlab.example.com/api/v4/projects/8/issues?page=1&per_page=20>; rel="first"`,
+		`<https://gitlab.example.com/api/v4/projects/8/issues?page=3&per_page=20>; rel="next"`,
+		`<https://gitlab.example.com/api/v4/projects/8/issues?page=12&per_page=20>; rel="last"`,
+	}, ", "))
+
+	r := newResponse(&http.Response{Header: h})
+
+	page, ok := r.LastPage()
+	if !ok || page != 12 {
+		t.Errorf("LastPage returned (%d, %t), want (12, true)", page, ok)
+	}
+
+	h = http.Header{}
+	h.Add("Link", `<https://gitlab.example.com/api/v4/projects?id_after=42&per_page=20&pagination=keyset>; rel="next"`)
+
+	r = newResponse(&http.Response{Header: h})
+
+	page, ok = r.LastPage()
+	if ok || page != 0 {
+		t.Errorf("LastPage returned (%d, %t), want (0, false)", page, ok)
+	}
+}
+
 func TestPaginationPopulatePageValuesKeyset(t *testing.T) {
 	wantPageHeaders := map[string]int{
 		xTotal:      0,
@@ -454,3 +774,40 @@ func TestExponentialBackoffLogic(t *testing.T) {
 		t.Fatal("Expected to get a 429 code given the server is hard-coded to return this. Received instead:", resp.StatusCode)
 	}
 }
+
+func TestPartialUpdateOptionsOmitUnsetFields(t *testing.T) {
+	// Unset fields of update options must not be sent, otherwise an update
+	// would reset them to their zero value.
+	opts := []interface{}{
+		&EditProjectOptions{},
+		&UpdateGroupOptions{},
+		&UpdateIssueOptions{},
+		&UpdateMergeRequestOptions{},
+		&UpdateReleaseOptions{},
+		&UpdateProtectedBranchOptions{},
+		&UpdateProjectVariableOptions{},
+		&ModifyUserOptions{},
+		&DeleteProjectOptions{},
+		&PostCommitCommentOptions{},
+		&AddProjectMemberOptions{},
+		&AddGroupMemberOptions{},
+	}
+
+	for _, opt := range opts {
+		body, err := json.Marshal(opt)
+		if err != nil {
+			t.Fatalf("json.Marshal(%T) returned error: %v", opt, err)
+		}
+		if string(body) != "{}" {
+			t.Errorf("%T encodes unset fields as JSON: %s", opt, body)
+		}
+
+		q, err := query.Values(opt)
+		if err != nil {
+			t.Fatalf("query.Values(%T) returned error: %v", opt, err)
+		}
+		if len(q) != 0 {
+			t.Errorf("%T encodes unset fields as query parameters: %s", opt, q.Encode())
+		}
+	}
+}
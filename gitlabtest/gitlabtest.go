@@ -0,0 +1,94 @@
+//
+// Copyright 2021, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+// Package gitlabtest provides helpers to test code that uses the gitlab
+// package against a local test server instead of a GitLab instance.
+//
+// Example usage:
+//
+//	func TestProjectName(t *testing.T) {
+//	    client, server, err := gitlabtest.NewClient(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+//	        m := gitlabtest.RequestMatcher{Method: http.MethodGet, Path: "/api/v4/projects/1"}
+//	        if err := m.Match(r); err != nil {
+//	            t.Error(err)
+//	        }
+//	        fmt.Fprint(w, `{"id":1,"name":"project"}`)
+//	    }))
+//	    ...
+//	    defer server.Close()
+//	    ...
+//	}
+package gitlabtest
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+
+	"github.com/xanzy/go-gitlab"
+)
+
+// NewClient starts a test server serving the given handler and returns a
+// client that sends its requests to it. The handler receives the full request
+// path, including the /api/v4/ prefix. Retries are disabled, so every request
+// reaches the handler exactly once. Any options are applied after the ones
+// set by NewClient.
+//
+// The caller is responsible for closing the returned server.
+func NewClient(handler http.Handler, options ...gitlab.ClientOptionFunc) (*gitlab.Client, *httptest.Server, error) {
+	server := httptest.NewServer(handler)
+
+	opts := append([]gitlab.ClientOptionFunc{
+		gitlab.WithBaseURL(server.URL),
+		gitlab.WithoutRetries(),
+	}, options...)
+
+	client, err := gitlab.NewClient("", opts...)
+	if err != nil {
+		server.Close()
+		return nil, nil, err
+	}
+
+	return client, server, nil
+}
+
+// RequestMatcher describes the request a test handler expects. Empty fields
+// are not checked. Path is compared against the decoded request path, so it
+// includes the /api/v4/ prefix. When Query is set, the query parameters of the
+// request must equal it exactly.
+type RequestMatcher struct {
+	Method string
+	Path   string
+	Query  url.Values
+}
+
+// Match returns an error describing the first difference between the request
+// and the matcher, or nil when the request matches.
+func (m RequestMatcher) Match(r *http.Request) error {
+	if m.Method != "" && r.Method != m.Method {
+		return fmt.Errorf("request method: %s, want %s", r.Method, m.Method)
+	}
+	if m.Path != "" && r.URL.Path != m.Path {
+		return fmt.Errorf("request path: %s, want %s", r.URL.Path, m.Path)
+	}
+	if m.Query != nil {
+		if got := r.URL.Query(); got.Encode() != m.Query.Encode() {
+			return fmt.Errorf("request query: %s, want %s", got.Encode(), m.Query.Encode())
+		}
+	}
+	return nil
+}
@@ -0,0 +1,93 @@
+//
+// Copyright 2021, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package gitlabtest
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/xanzy/go-gitlab"
+)
+
+func TestNewClient(t *testing.T) {
+	calls := 0
+	client, server, err := NewClient(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		m := RequestMatcher{
+			Method: http.MethodGet,
+			Path:   "/api/v4/projects/group/project",
+			Query:  url.Values{"statistics": {"true"}},
+		}
+		if err := m.Match(r); err != nil {
+			t.Error(err)
+		}
+		fmt.Fprint(w, `{"id":1,"path_with_namespace":"group/project"}`)
+	}))
+	require.NoError(t, err)
+	defer server.Close()
+
+	project, _, err := client.Projects.GetProject("group/project", &gitlab.GetProjectOptions{Statistics: gitlab.Ptr(true)})
+	require.NoError(t, err)
+	assert.Equal(t, "group/project", project.PathWithNamespace)
+	assert.Equal(t, 1, calls)
+}
+
+func TestNewClientWithoutRetries(t *testing.T) {
+	calls := 0
+	client, server, err := NewClient(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	require.NoError(t, err)
+	defer server.Close()
+
+	_, resp, err := client.Projects.GetProject(1, nil)
+	require.Error(t, err)
+	assert.Equal(t, http.StatusServiceUnavailable, resp.StatusCode)
+	assert.Equal(t, 1, calls)
+}
+
+func TestRequestMatcher(t *testing.T) {
+	r := httptest.NewRequest(http.MethodPost, "/api/v4/projects/1/issues?labels=bug&state=opened", nil)
+
+	tests := []struct {
+		name    string
+		matcher RequestMatcher
+		wantErr bool
+	}{
+		{"empty", RequestMatcher{}, false},
+		{"full", RequestMatcher{Method: http.MethodPost, Path: "/api/v4/projects/1/issues", Query: url.Values{"state": {"opened"}, "labels": {"bug"}}}, false},
+		{"method", RequestMatcher{Method: http.MethodGet}, true},
+		{"path", RequestMatcher{Path: "/api/v4/projects/2/issues"}, true},
+		{"query", RequestMatcher{Query: url.Values{"state": {"opened"}}}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.matcher.Match(r)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -83,6 +83,57 @@ func (s *GroupAccessTokensService) ListGroupAccessTokens(gid interface{}, opt *L
 	return gats, resp, nil
 }
 
+// ExpiringGroupAccessToken represents a group access token that expires
+// soon, together with the full path of the group it belongs to.
+type ExpiringGroupAccessToken struct {
+	*GroupAccessToken
+	GroupPath string `json:"group_path"`
+}
+
+func (t ExpiringGroupAccessToken) String() string {
+	return Stringify(t)
+}
+
+// ListExpiringGroupAccessTokens gets all access tokens of a group that
+// expire before the given time, including tokens that already expired.
+// Revoked tokens and tokens without an expiry date are left out.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/group_access_tokens.html#list-group-access-tokens
+func (s *GroupAccessTokensService) ListExpiringGroupAccessTokens(gid interface{}, before time.Time, options ...RequestOptionFunc) ([]*ExpiringGroupAccessToken, *Response, error) {
+	g, resp, err := s.client.Groups.GetGroup(gid, &GetGroupOptions{WithProjects: Ptr(false)}, options...)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	var expiring []*ExpiringGroupAccessToken
+
+	opt := &ListGroupAccessTokensOptions{PerPage: 100}
+	for {
+		ts, r, err := s.ListGroupAccessTokens(gid, opt, options...)
+		if err != nil {
+			return nil, r, err
+		}
+		for _, t := range ts {
+			if t.Revoked || t.ExpiresAt == nil || !time.Time(*t.ExpiresAt).Before(before) {
+				continue
+			}
+			expiring = append(expiring, &ExpiringGroupAccessToken{
+				GroupAccessToken: t,
+				GroupPath:        g.FullPath,
+			})
+		}
+		resp = r
+
+		if r.NextPage == 0 {
+			break
+		}
+		opt.Page = r.NextPage
+	}
+
+	return expiring, resp, nil
+}
+
 // GetGroupAccessToken gets a single group access tokens in a group.
 //
 // GitLab API docs:
@@ -173,7 +224,7 @@ func (s *GroupAccessTokensService) RotateGroupAccessToken(gid interface{}, id in
 	gat := new(GroupAccessToken)
 	resp, err := s.client.Do(req, gat)
 	if err != nil {
-		return nil, resp, err
+		return nil, resp, rotateAccessTokenError(resp, err)
 	}
 
 	return gat, resp, nil
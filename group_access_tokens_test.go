@@ -17,8 +17,10 @@
 package gitlab
 
 import (
+	"fmt"
 	"net/http"
 	"reflect"
+	"strings"
 	"testing"
 	"time"
 )
@@ -78,6 +80,50 @@ func TestListGroupAccessTokens(t *testing.T) {
 	}
 }
 
+func TestListExpiringGroupAccessTokens(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/groups/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testParams(t, r, "with_projects=false")
+		fmt.Fprint(w, `{"id":1,"full_path":"parent/group"}`)
+	})
+	mux.HandleFunc("/api/v4/groups/1/access_tokens", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		if r.URL.Query().Get("page") == "2" {
+			fmt.Fprint(w, `[
+				{"id":3,"name":"revoked","expires_at":"2024-06-10","active":false,"revoked":true},
+				{"id":4,"name":"later","expires_at":"2024-08-01","active":true}
+			]`)
+			return
+		}
+		w.Header().Set("X-Next-Page", "2")
+		fmt.Fprint(w, `[
+			{"id":1,"name":"expiring","expires_at":"2024-06-20","active":true},
+			{"id":2,"name":"no expiry","expires_at":null,"active":true}
+		]`)
+	})
+
+	now := time.Date(2024, time.June, 1, 0, 0, 0, 0, time.UTC)
+	tokens, _, err := client.GroupAccessTokens.ListExpiringGroupAccessTokens(1, now.AddDate(0, 0, 30))
+	if err != nil {
+		t.Fatalf("GroupAccessTokens.ListExpiringGroupAccessTokens returned error: %v", err)
+	}
+
+	expiresAt := ISOTime(time.Date(2024, time.June, 20, 0, 0, 0, 0, time.UTC))
+	want := []*ExpiringGroupAccessToken{{
+		GroupAccessToken: &GroupAccessToken{ID: 1, Name: "expiring", ExpiresAt: &expiresAt, Active: true},
+		GroupPath:        "parent/group",
+	}}
+	if !reflect.DeepEqual(want, tokens) {
+		t.Errorf("GroupAccessTokens.ListExpiringGroupAccessTokens returned %+v, want %+v", tokens, want)
+	}
+
+	if s := tokens[0].String(); !strings.Contains(s, `GroupPath:"parent/group"`) {
+		t.Errorf("ExpiringGroupAccessToken.String() = %s, want it to include the group path", s)
+	}
+}
+
 func TestGetGroupAccessToken(t *testing.T) {
 	mux, client := setup(t)
 
@@ -17,6 +17,7 @@
 package gitlab
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"time"
@@ -46,6 +47,7 @@ type GroupMember struct {
 	Email             string                   `json:"email,omitempty"`
 	GroupSAMLIdentity *GroupMemberSAMLIdentity `json:"group_saml_identity"`
 	MemberRole        *MemberRole              `json:"member_role"`
+	MembershipState   string                   `json:"membership_state"`
 }
 
 // GroupMemberSAMLIdentity represents the SAML Identity link for the group member.
@@ -163,7 +165,7 @@ type AddGroupMemberOptions struct {
 	UserID       *int              `url:"user_id,omitempty" json:"user_id,omitempty"`
 	Username     *string           `url:"username,omitempty" json:"username,omitempty"`
 	AccessLevel  *AccessLevelValue `url:"access_level,omitempty" json:"access_level,omitempty"`
-	ExpiresAt    *string           `url:"expires_at,omitempty" json:"expires_at"`
+	ExpiresAt    *string           `url:"expires_at,omitempty" json:"expires_at,omitempty"`
 	MemberRoleID *int              `url:"member_role_id,omitempty" json:"member_role_id,omitempty"`
 }
 
@@ -439,3 +441,161 @@ func (s *GroupMembersService) RemoveGroupMember(gid interface{}, user int, opt *
 
 	return s.client.Do(req, nil)
 }
+
+// GroupTreeMember represents a user with access somewhere in a group tree,
+// together with the full path of the group or project granting that access.
+type GroupTreeMember struct {
+	UserID      int              `json:"user_id"`
+	Username    string           `json:"username"`
+	Name        string           `json:"name"`
+	AccessLevel AccessLevelValue `json:"access_level"`
+	SourcePath  string           `json:"source_path"`
+}
+
+func (m GroupTreeMember) String() string {
+	return Stringify(m)
+}
+
+// WalkGroupTreeMembers calls fn for every membership in a group tree: the
+// members of the group itself (including members inherited from its
+// ancestors), the direct members of all its descendant groups and the direct
+// members of all projects in those groups. A user with access through several
+// sources is passed to fn once per source. Walking stops at the first error
+// returned by fn, which is then returned.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/members.html#list-all-members-of-a-group-or-project
+func (s *GroupsService) WalkGroupTreeMembers(ctx context.Context, gid interface{}, fn func(*GroupTreeMember) error, options ...RequestOptionFunc) (*Response, error) {
+	options = append([]RequestOptionFunc{WithContext(ctx)}, options...)
+
+	root, resp, err := s.GetGroup(gid, &GetGroupOptions{WithProjects: Ptr(false)}, options...)
+	if err != nil {
+		return resp, err
+	}
+
+	visitGroup := func(g *Group, list func(interface{}, *ListGroupMembersOptions, ...RequestOptionFunc) ([]*GroupMember, *Response, error)) error {
+		opt := &ListGroupMembersOptions{ListOptions: ListOptions{PerPage: 100}}
+		for {
+			gms, r, err := list(g.ID, opt, options...)
+			resp = r
+			if err != nil {
+				return err
+			}
+			for _, gm := range gms {
+				err := fn(&GroupTreeMember{
+					UserID:      gm.ID,
+					Username:    gm.Username,
+					Name:        gm.Name,
+					AccessLevel: gm.AccessLevel,
+					SourcePath:  g.FullPath,
+				})
+				if err != nil {
+					return err
+				}
+			}
+			if r.NextPage == 0 {
+				return nil
+			}
+			opt.Page = r.NextPage
+		}
+	}
+
+	if err := visitGroup(root, s.ListAllGroupMembers); err != nil {
+		return resp, err
+	}
+
+	gopt := &ListDescendantGroupsOptions{ListOptions: ListOptions{PerPage: 100}}
+	for {
+		gs, r, err := s.ListDescendantGroups(root.ID, gopt, options...)
+		resp = r
+		if err != nil {
+			return resp, err
+		}
+		for _, g := range gs {
+			if err := visitGroup(g, s.ListGroupMembers); err != nil {
+				return resp, err
+			}
+		}
+		if r.NextPage == 0 {
+			break
+		}
+		gopt.Page = r.NextPage
+	}
+
+	popt := &ListGroupProjectsOptions{
+		ListOptions:      ListOptions{PerPage: 100},
+		IncludeSubGroups: Ptr(true),
+		WithShared:       Ptr(false),
+	}
+	for {
+		ps, r, err := s.ListGroupProjects(root.ID, popt, options...)
+		resp = r
+		if err != nil {
+			return resp, err
+		}
+		for _, p := range ps {
+			mopt := &ListProjectMembersOptions{ListOptions: ListOptions{PerPage: 100}}
+			for {
+				pms, r, err := s.client.ProjectMembers.ListProjectMembers(p.ID, mopt, options...)
+				resp = r
+				if err != nil {
+					return resp, err
+				}
+				for _, pm := range pms {
+					err := fn(&GroupTreeMember{
+						UserID:      pm.ID,
+						Username:    pm.Username,
+						Name:        pm.Name,
+						AccessLevel: pm.AccessLevel,
+						SourcePath:  p.PathWithNamespace,
+					})
+					if err != nil {
+						return resp, err
+					}
+				}
+				if r.NextPage == 0 {
+					break
+				}
+				mopt.Page = r.NextPage
+			}
+		}
+		if r.NextPage == 0 {
+			break
+		}
+		popt.Page = r.NextPage
+	}
+
+	return resp, nil
+}
+
+// ListAllMembersInGroupTree gets every user with access anywhere in a group
+// tree. Users with access through several groups or projects are listed once,
+// with their highest access level and the path of the source granting it.
+//
+// This method walks the whole tree with WalkGroupTreeMembers and keeps all
+// members in memory; use WalkGroupTreeMembers directly for very large trees.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/members.html#list-all-members-of-a-group-or-project
+func (s *GroupsService) ListAllMembersInGroupTree(ctx context.Context, gid interface{}, options ...RequestOptionFunc) ([]*GroupTreeMember, *Response, error) {
+	var members []*GroupTreeMember
+	byID := make(map[int]*GroupTreeMember)
+
+	resp, err := s.WalkGroupTreeMembers(ctx, gid, func(m *GroupTreeMember) error {
+		cur, ok := byID[m.UserID]
+		if !ok {
+			byID[m.UserID] = m
+			members = append(members, m)
+			return nil
+		}
+		if m.AccessLevel > cur.AccessLevel {
+			*cur = *m
+		}
+		return nil
+	}, options...)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return members, resp, nil
+}
@@ -17,6 +17,7 @@
 package gitlab
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"reflect"
@@ -447,3 +448,83 @@ func TestGetGroupMemberAll(t *testing.T) {
 	require.Nil(t, member)
 	require.Equal(t, http.StatusNotFound, resp.StatusCode)
 }
+
+func TestListAllGroupMembersPagination(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/groups/1/members/all", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		switch r.URL.Query().Get("page") {
+		case "", "1":
+			testParams(t, r, "per_page=1&query=a&user_ids%5B%5D=10&user_ids%5B%5D=11")
+			w.Header().Set("X-Next-Page", "2")
+			fmt.Fprint(w, `[{"id":10,"username":"alice","access_level":30,"expires_at":"2025-01-01","membership_state":"active","group_saml_identity":{"extern_uid":"alice","provider":"group_saml","saml_provider_id":1}}]`)
+		case "2":
+			fmt.Fprint(w, `[{"id":11,"username":"anna","access_level":20,"membership_state":"awaiting"}]`)
+		}
+	})
+
+	opt := &ListGroupMembersOptions{
+		ListOptions: ListOptions{PerPage: 1},
+		Query:       Ptr("a"),
+		UserIDs:     &[]int{10, 11},
+	}
+
+	var members []*GroupMember
+	for {
+		ms, resp, err := client.Groups.ListAllGroupMembers(1, opt)
+		require.NoError(t, err)
+		members = append(members, ms...)
+		if resp.NextPage == 0 {
+			break
+		}
+		opt.Page = resp.NextPage
+	}
+
+	require.Len(t, members, 2)
+	assert.Equal(t, DeveloperPermissions, members[0].AccessLevel)
+	assert.Equal(t, "2025-01-01", members[0].ExpiresAt.String())
+	assert.Equal(t, "active", members[0].MembershipState)
+	assert.Equal(t, "alice", members[0].GroupSAMLIdentity.ExternUID)
+	assert.Equal(t, "awaiting", members[1].MembershipState)
+}
+
+func TestListAllMembersInGroupTree(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/groups/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testParams(t, r, "with_projects=false")
+		fmt.Fprint(w, `{"id":1,"full_path":"top"}`)
+	})
+	mux.HandleFunc("/api/v4/groups/1/members/all", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `[{"id":10,"username":"alice","access_level":30}]`)
+	})
+	mux.HandleFunc("/api/v4/groups/1/descendant_groups", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `[{"id":2,"full_path":"top/sub"}]`)
+	})
+	mux.HandleFunc("/api/v4/groups/2/members", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `[{"id":11,"username":"bob","access_level":20}]`)
+	})
+	mux.HandleFunc("/api/v4/groups/1/projects", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testParams(t, r, "include_subgroups=true&per_page=100&with_shared=false")
+		fmt.Fprint(w, `[{"id":3,"path_with_namespace":"top/sub/app"}]`)
+	})
+	mux.HandleFunc("/api/v4/projects/3/members", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `[{"id":10,"username":"alice","access_level":40}]`)
+	})
+
+	members, _, err := client.Groups.ListAllMembersInGroupTree(context.Background(), 1)
+	require.NoError(t, err)
+
+	want := []*GroupTreeMember{
+		{UserID: 10, Username: "alice", AccessLevel: MaintainerPermissions, SourcePath: "top/sub/app"},
+		{UserID: 11, Username: "bob", AccessLevel: ReporterPermissions, SourcePath: "top/sub"},
+	}
+	assert.Equal(t, want, members)
+}
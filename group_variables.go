@@ -134,7 +134,16 @@ type CreateGroupVariableOptions struct {
 	VariableType     *VariableTypeValue `url:"variable_type,omitempty" json:"variable_type,omitempty"`
 }
 
-// CreateVariable creates a new group variable.
+// validate checks the value of a masked variable before it is sent.
+func (opt *CreateGroupVariableOptions) validate() error {
+	if opt == nil {
+		return nil
+	}
+	return validateMaskedOption(opt.Value, opt.Masked, opt.MaskedAndHidden)
+}
+
+// CreateVariable creates a new group variable. When the variable is masked,
+// its value is checked with ValidateMaskedValue before the request is sent.
 //
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/group_level_variables.html#create-variable
@@ -143,6 +152,9 @@ func (s *GroupVariablesService) CreateVariable(gid interface{}, opt *CreateGroup
 	if err != nil {
 		return nil, nil, err
 	}
+	if err := opt.validate(); err != nil {
+		return nil, nil, err
+	}
 	u := fmt.Sprintf("groups/%s/variables", PathEscape(group))
 
 	req, err := s.client.NewRequest(http.MethodPost, u, opt, options)
@@ -174,6 +186,14 @@ type UpdateGroupVariableOptions struct {
 	VariableType     *VariableTypeValue `url:"variable_type,omitempty" json:"variable_type,omitempty"`
 }
 
+// validate checks the value of a masked variable before it is sent.
+func (opt *UpdateGroupVariableOptions) validate() error {
+	if opt == nil {
+		return nil
+	}
+	return validateMaskedOption(opt.Value, opt.Masked)
+}
+
 // UpdateVariable updates the position of an existing
 // group issue board list.
 //
@@ -184,6 +204,9 @@ func (s *GroupVariablesService) UpdateVariable(gid interface{}, key string, opt
 	if err != nil {
 		return nil, nil, err
 	}
+	if err := opt.validate(); err != nil {
+		return nil, nil, err
+	}
 	u := fmt.Sprintf("groups/%s/variables/%s", PathEscape(group), url.PathEscape(key))
 
 	req, err := s.client.NewRequest(http.MethodPut, u, opt, options)
@@ -79,12 +79,12 @@ func TestCreateGroupVariable(t *testing.T) {
 	mux.HandleFunc("/api/v4/groups/1/variables",
 		func(w http.ResponseWriter, r *http.Request) {
 			testMethod(t, r, http.MethodPost)
-			fmt.Fprint(w, `{"key": "TEST_VARIABLE_1","value":"test1","protected": false,"masked": true,"hidden": false}`)
+			fmt.Fprint(w, `{"key": "TEST_VARIABLE_1","value":"test1234","protected": false,"masked": true,"hidden": false}`)
 		})
 
 	opt := &CreateGroupVariableOptions{
 		Key:             Ptr("TEST_VARIABLE_1"),
-		Value:           Ptr("test1"),
+		Value:           Ptr("test1234"),
 		Protected:       Ptr(false),
 		Masked:          Ptr(true),
 		MaskedAndHidden: Ptr(false),
@@ -95,7 +95,7 @@ func TestCreateGroupVariable(t *testing.T) {
 		t.Errorf("GroupVariables.CreateVariable returned error: %v", err)
 	}
 
-	want := &GroupVariable{Key: "TEST_VARIABLE_1", Value: "test1", Protected: false, Masked: true, Hidden: false}
+	want := &GroupVariable{Key: "TEST_VARIABLE_1", Value: "test1234", Protected: false, Masked: true, Hidden: false}
 	if !reflect.DeepEqual(want, variable) {
 		t.Errorf("GroupVariables.CreateVariable returned %+v, want %+v", variable, want)
 	}
@@ -112,7 +112,7 @@ func TestCreateGroupVariable_MaskedAndHidden(t *testing.T) {
 
 	opt := &CreateGroupVariableOptions{
 		Key:             Ptr("TEST_VARIABLE_1"),
-		Value:           Ptr("test1"),
+		Value:           Ptr("test1234"),
 		Protected:       Ptr(false),
 		Masked:          Ptr(true),
 		MaskedAndHidden: Ptr(true),
@@ -159,7 +159,9 @@ type EditGroupWikiPageOptions struct {
 }
 
 // EditGroupWikiPage Updates an existing wiki page. At least one parameter is
-// required to update the wiki page.
+// required to update the wiki page. Note that changing the title also changes
+// the slug of the page. Use MoveGroupWikiPage to get both the old and the new
+// slug.
 //
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/group_wikis.html#edit-an-existing-wiki-page
@@ -184,6 +186,31 @@ func (s *GroupWikisService) EditGroupWikiPage(gid interface{}, slug string, opt
 	return w, resp, nil
 }
 
+// GroupWikiPageMove represents the result of moving a group wiki page.
+type GroupWikiPageMove struct {
+	OldSlug string
+	NewSlug string
+	Page    *GroupWiki
+}
+
+// MoveGroupWikiPage renames a group wiki page by changing its title, which
+// also changes its slug. The returned error matches ErrWikiPageExists when
+// the new slug is already in use.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/group_wikis.html#edit-an-existing-wiki-page
+func (s *GroupWikisService) MoveGroupWikiPage(gid interface{}, slug, title string, options ...RequestOptionFunc) (*GroupWikiPageMove, *Response, error) {
+	w, resp, err := s.EditGroupWikiPage(gid, slug, &EditGroupWikiPageOptions{Title: Ptr(title)}, options...)
+	if err != nil {
+		if resp != nil && resp.StatusCode == http.StatusConflict {
+			return nil, resp, &sentinelError{sentinel: ErrWikiPageExists, err: err}
+		}
+		return nil, resp, err
+	}
+
+	return &GroupWikiPageMove{OldSlug: slug, NewSlug: w.Slug, Page: w}, resp, nil
+}
+
 // DeleteGroupWikiPage deletes a wiki page with a given slug.
 //
 // GitLab API docs:
@@ -1,9 +1,11 @@
 package gitlab
 
 import (
+	"errors"
 	"fmt"
 	"net/http"
 	"reflect"
+	"strings"
 	"testing"
 )
 
@@ -158,3 +160,65 @@ func TestDeleteGroupWikiPage(t *testing.T) {
 		t.Errorf("GroupWikis.DeleteGroupWikiPage returned wrong status code %d != 204", r.StatusCode)
 	}
 }
+
+func TestMoveGroupWikiPage(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/groups/1/wikis/old-page", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPut)
+		testBody(t, r, `{"title":"New Page"}`)
+		fmt.Fprint(w, `{"content":"content","format":"markdown","slug":"New-Page","title":"New Page"}`)
+	})
+
+	move, _, err := client.GroupWikis.MoveGroupWikiPage(1, "old-page", "New Page")
+	if err != nil {
+		t.Fatalf("GroupWikis.MoveGroupWikiPage returned error: %v", err)
+	}
+	if move.OldSlug != "old-page" || move.NewSlug != "New-Page" {
+		t.Errorf("GroupWikis.MoveGroupWikiPage returned slugs %q -> %q, want %q -> %q", move.OldSlug, move.NewSlug, "old-page", "New-Page")
+	}
+}
+
+func TestMoveGroupWikiPageConflict(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/groups/1/wikis/old-page", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPut)
+		w.WriteHeader(http.StatusConflict)
+		fmt.Fprint(w, `{"message":"Duplicate page: A page with that title already exists"}`)
+	})
+
+	_, _, err := client.GroupWikis.MoveGroupWikiPage(1, "old-page", "Existing")
+	if !errors.Is(err, ErrWikiPageExists) {
+		t.Errorf("GroupWikis.MoveGroupWikiPage returned error %v, want %v", err, ErrWikiPageExists)
+	}
+
+	var errResp *ErrorResponse
+	if !errors.As(err, &errResp) {
+		t.Fatalf("expected the error to wrap an *ErrorResponse, got %T", err)
+	}
+	if !strings.Contains(errResp.Message, "Duplicate page") {
+		t.Errorf("expected GitLab's message to be kept, got %q", errResp.Message)
+	}
+}
+
+func TestMoveGroupWikiPageBadRequest(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/groups/1/wikis/old-page", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPut)
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(w, `{"error":"title is empty"}`)
+	})
+
+	_, resp, err := client.GroupWikis.MoveGroupWikiPage(1, "old-page", "")
+	if err == nil {
+		t.Fatal("GroupWikis.MoveGroupWikiPage expected an error")
+	}
+	if resp.StatusCode != http.StatusBadRequest {
+		t.Errorf("expected status code 400, got %d", resp.StatusCode)
+	}
+	if errors.Is(err, ErrWikiPageExists) {
+		t.Errorf("GroupWikis.MoveGroupWikiPage returned %v, which should not match ErrWikiPageExists", err)
+	}
+}
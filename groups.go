@@ -19,6 +19,7 @@ package gitlab
 import (
 	"bytes"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
@@ -27,6 +28,10 @@ import (
 	retryablehttp "github.com/hashicorp/go-retryablehttp"
 )
 
+// ErrGroupStatisticsForbidden is returned when group statistics are requested
+// without administrator access.
+var ErrGroupStatisticsForbidden = errors.New("group statistics can only be requested by administrators")
+
 // GroupsService handles communication with the group related methods of
 // the GitLab API.
 //
@@ -152,6 +157,23 @@ type SAMLGroupLink struct {
 	MemberRoleID int              `json:"member_role_id,omitempty"`
 }
 
+// GroupSAMLIdentity represents the SAML identity of a group member.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/api/saml.html
+type GroupSAMLIdentity struct {
+	ExternUID string `json:"extern_uid"`
+	UserID    int    `json:"user_id"`
+}
+
+// GroupSCIMIdentity represents the SCIM identity of a group member.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/api/scim.html
+type GroupSCIMIdentity struct {
+	ExternUID string `json:"extern_uid"`
+	UserID    int    `json:"user_id"`
+	Active    bool   `json:"active"`
+}
+
 // ListGroupsOptions represents the available ListGroups() options.
 //
 // GitLab API docs: https://docs.gitlab.com/ee/api/groups.html#list-groups
@@ -172,6 +194,10 @@ type ListGroupsOptions struct {
 
 // ListGroups gets a list of groups (as user: my groups, as admin: all groups).
 //
+// When Statistics is set, the storage statistics of every group are decoded
+// into Group.Statistics. Statistics are only available to administrators; if
+// GitLab refuses them, the returned error matches ErrGroupStatisticsForbidden.
+//
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/groups.html#list-groups
 func (s *GroupsService) ListGroups(opt *ListGroupsOptions, options ...RequestOptionFunc) ([]*Group, *Response, error) {
@@ -183,6 +209,10 @@ func (s *GroupsService) ListGroups(opt *ListGroupsOptions, options ...RequestOpt
 	var gs []*Group
 	resp, err := s.client.Do(req, &gs)
 	if err != nil {
+		if opt != nil && opt.Statistics != nil && *opt.Statistics &&
+			resp != nil && resp.StatusCode == http.StatusForbidden {
+			return nil, resp, &sentinelError{sentinel: ErrGroupStatisticsForbidden, err: err}
+		}
 		return nil, resp, err
 	}
 
@@ -974,6 +1004,110 @@ func (s *GroupsService) DeleteGroupSAMLLink(gid interface{}, samlGroupName strin
 	return s.client.Do(req, nil)
 }
 
+// ListGroupSAMLIdentities lists the SAML identities of a group. Available
+// only for group owners.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/saml.html#get-saml-identities-for-a-group
+func (s *GroupsService) ListGroupSAMLIdentities(gid interface{}, options ...RequestOptionFunc) ([]*GroupSAMLIdentity, *Response, error) {
+	group, err := parseID(gid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("groups/%s/saml/identities", PathEscape(group))
+
+	req, err := s.client.NewRequest(http.MethodGet, u, nil, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	ids := []*GroupSAMLIdentity{}
+	resp, err := s.client.Do(req, &ids)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return ids, resp, nil
+}
+
+// GetGroupSAMLIdentity gets the SAML identity of a group member by its
+// external UID. Available only for group owners.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/saml.html#get-a-single-saml-identity
+func (s *GroupsService) GetGroupSAMLIdentity(gid interface{}, uid string, options ...RequestOptionFunc) (*GroupSAMLIdentity, *Response, error) {
+	group, err := parseID(gid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("groups/%s/saml/%s", PathEscape(group), PathEscape(uid))
+
+	req, err := s.client.NewRequest(http.MethodGet, u, nil, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	id := new(GroupSAMLIdentity)
+	resp, err := s.client.Do(req, id)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return id, resp, nil
+}
+
+// ListGroupSCIMIdentities lists the SCIM identities of a group. Available
+// only for group owners.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/scim.html#get-scim-identities-for-a-group
+func (s *GroupsService) ListGroupSCIMIdentities(gid interface{}, options ...RequestOptionFunc) ([]*GroupSCIMIdentity, *Response, error) {
+	group, err := parseID(gid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("groups/%s/scim/identities", PathEscape(group))
+
+	req, err := s.client.NewRequest(http.MethodGet, u, nil, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	ids := []*GroupSCIMIdentity{}
+	resp, err := s.client.Do(req, &ids)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return ids, resp, nil
+}
+
+// GetGroupSCIMIdentity gets the SCIM identity of a group member by its
+// external UID. Available only for group owners.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/scim.html#get-a-single-scim-identity
+func (s *GroupsService) GetGroupSCIMIdentity(gid interface{}, uid string, options ...RequestOptionFunc) (*GroupSCIMIdentity, *Response, error) {
+	group, err := parseID(gid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("groups/%s/scim/%s", PathEscape(group), PathEscape(uid))
+
+	req, err := s.client.NewRequest(http.MethodGet, u, nil, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	id := new(GroupSCIMIdentity)
+	resp, err := s.client.Do(req, id)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return id, resp, nil
+}
+
 // ShareGroupWithGroupOptions represents the available ShareGroupWithGroup() options.
 //
 // GitLab API docs:
@@ -1185,3 +1319,25 @@ func (s *GroupsService) DeleteGroupPushRule(gid interface{}, options ...RequestO
 
 	return s.client.Do(req, nil)
 }
+
+// PurgeDependencyProxyCache schedules the removal of all cached manifests and
+// blobs of the dependency proxy of a group. The purge runs asynchronously;
+// GitLab responds with 202 Accepted once it is scheduled, so the returned
+// response does not mean the cache is already empty.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/dependency_proxy.html#purge-the-dependency-proxy-for-a-group
+func (s *GroupsService) PurgeDependencyProxyCache(gid interface{}, options ...RequestOptionFunc) (*Response, error) {
+	group, err := parseID(gid)
+	if err != nil {
+		return nil, err
+	}
+	u := fmt.Sprintf("groups/%s/dependency_proxy/cache", PathEscape(group))
+
+	req, err := s.client.NewRequest(http.MethodDelete, u, nil, options)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(req, nil)
+}
@@ -32,6 +32,68 @@ func TestListGroups(t *testing.T) {
 	}
 }
 
+func TestListGroupsWithStatistics(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/groups",
+		func(w http.ResponseWriter, r *http.Request) {
+			testMethod(t, r, http.MethodGet)
+			testParams(t, r, "statistics=true")
+			fmt.Fprint(w, `[{
+				"id": 1,
+				"statistics": {
+					"storage_size": 1000,
+					"repository_size": 400,
+					"wiki_size": 50,
+					"lfs_objects_size": 200,
+					"job_artifacts_size": 150,
+					"packages_size": 100,
+					"snippets_size": 20,
+					"uploads_size": 80
+				}
+			}]`)
+		})
+
+	groups, _, err := client.Groups.ListGroups(&ListGroupsOptions{Statistics: Ptr(true)})
+	if err != nil {
+		t.Errorf("Groups.ListGroups returned error: %v", err)
+	}
+
+	want := []*Group{{
+		ID: 1,
+		Statistics: &Statistics{
+			StorageSize:      1000,
+			RepositorySize:   400,
+			WikiSize:         50,
+			LFSObjectsSize:   200,
+			JobArtifactsSize: 150,
+			PackagesSize:     100,
+			SnippetsSize:     20,
+			UploadsSize:      80,
+		},
+	}}
+	if !reflect.DeepEqual(want, groups) {
+		t.Errorf("Groups.ListGroups returned %+v, want %+v", groups, want)
+	}
+}
+
+func TestListGroupsWithStatisticsForbidden(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/groups",
+		func(w http.ResponseWriter, r *http.Request) {
+			testMethod(t, r, http.MethodGet)
+			http.Error(w, `{"message":"403 Forbidden"}`, http.StatusForbidden)
+		})
+
+	_, resp, err := client.Groups.ListGroups(&ListGroupsOptions{Statistics: Ptr(true)})
+	assert.ErrorIs(t, err, ErrGroupStatisticsForbidden)
+	assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+
+	var errResp *ErrorResponse
+	assert.ErrorAs(t, err, &errResp)
+}
+
 func TestGetGroup(t *testing.T) {
 	mux, client := setup(t)
 
@@ -572,6 +634,104 @@ func TestListGroupSAMLLinks(t *testing.T) {
 	}
 }
 
+func TestListGroupSAMLIdentities(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/groups/1/saml/identities",
+		func(w http.ResponseWriter, r *http.Request) {
+			testMethod(t, r, http.MethodGet)
+			fmt.Fprint(w, `[{"extern_uid":"yrnZW46BrtBFqM7xDzE7dddd","user_id":48}]`)
+		})
+
+	ids, _, err := client.Groups.ListGroupSAMLIdentities(1)
+	if err != nil {
+		t.Errorf("Groups.ListGroupSAMLIdentities returned error: %v", err)
+	}
+
+	want := []*GroupSAMLIdentity{{ExternUID: "yrnZW46BrtBFqM7xDzE7dddd", UserID: 48}}
+	if !reflect.DeepEqual(want, ids) {
+		t.Errorf("Groups.ListGroupSAMLIdentities returned %+v, want %+v", ids, want)
+	}
+}
+
+func TestListGroupSAMLIdentitiesEmpty(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/groups/1/saml/identities",
+		func(w http.ResponseWriter, r *http.Request) {
+			testMethod(t, r, http.MethodGet)
+			fmt.Fprint(w, `[]`)
+		})
+
+	ids, _, err := client.Groups.ListGroupSAMLIdentities(1)
+	if err != nil {
+		t.Errorf("Groups.ListGroupSAMLIdentities returned error: %v", err)
+	}
+	if ids == nil || len(ids) != 0 {
+		t.Errorf("Groups.ListGroupSAMLIdentities returned %+v, want an empty list", ids)
+	}
+}
+
+func TestGetGroupSAMLIdentity(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/groups/1/saml/yrnZW46BrtBFqM7xDzE7dddd",
+		func(w http.ResponseWriter, r *http.Request) {
+			testMethod(t, r, http.MethodGet)
+			fmt.Fprint(w, `{"extern_uid":"yrnZW46BrtBFqM7xDzE7dddd","user_id":48}`)
+		})
+
+	id, _, err := client.Groups.GetGroupSAMLIdentity(1, "yrnZW46BrtBFqM7xDzE7dddd")
+	if err != nil {
+		t.Errorf("Groups.GetGroupSAMLIdentity returned error: %v", err)
+	}
+
+	want := &GroupSAMLIdentity{ExternUID: "yrnZW46BrtBFqM7xDzE7dddd", UserID: 48}
+	if !reflect.DeepEqual(want, id) {
+		t.Errorf("Groups.GetGroupSAMLIdentity returned %+v, want %+v", id, want)
+	}
+}
+
+func TestListGroupSCIMIdentities(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/groups/1/scim/identities",
+		func(w http.ResponseWriter, r *http.Request) {
+			testMethod(t, r, http.MethodGet)
+			fmt.Fprint(w, `[{"extern_uid":"be20d8dcc028677c931e04f387","user_id":48,"active":true}]`)
+		})
+
+	ids, _, err := client.Groups.ListGroupSCIMIdentities(1)
+	if err != nil {
+		t.Errorf("Groups.ListGroupSCIMIdentities returned error: %v", err)
+	}
+
+	want := []*GroupSCIMIdentity{{ExternUID: "be20d8dcc028677c931e04f387", UserID: 48, Active: true}}
+	if !reflect.DeepEqual(want, ids) {
+		t.Errorf("Groups.ListGroupSCIMIdentities returned %+v, want %+v", ids, want)
+	}
+}
+
+func TestGetGroupSCIMIdentity(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/groups/1/scim/be20d8dcc028677c931e04f387",
+		func(w http.ResponseWriter, r *http.Request) {
+			testMethod(t, r, http.MethodGet)
+			fmt.Fprint(w, `{"extern_uid":"be20d8dcc028677c931e04f387","user_id":48,"active":false}`)
+		})
+
+	id, _, err := client.Groups.GetGroupSCIMIdentity(1, "be20d8dcc028677c931e04f387")
+	if err != nil {
+		t.Errorf("Groups.GetGroupSCIMIdentity returned error: %v", err)
+	}
+
+	want := &GroupSCIMIdentity{ExternUID: "be20d8dcc028677c931e04f387", UserID: 48}
+	if !reflect.DeepEqual(want, id) {
+		t.Errorf("Groups.GetGroupSCIMIdentity returned %+v, want %+v", id, want)
+	}
+}
+
 func TestListGroupSAMLLinksCustomRole(t *testing.T) {
 	mux, client := setup(t)
 
@@ -1154,3 +1314,24 @@ func TestUpdateGroupWithAllowedEmailDomainsList(t *testing.T) {
 		t.Errorf("Groups.UpdatedGroup returned %+v, want %+v", group, want)
 	}
 }
+
+func TestPurgeDependencyProxyCache(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/groups/1/dependency_proxy/cache",
+		func(w http.ResponseWriter, r *http.Request) {
+			testMethod(t, r, http.MethodDelete)
+			w.WriteHeader(http.StatusAccepted)
+		})
+
+	resp, err := client.Groups.PurgeDependencyProxyCache(1)
+	if err != nil {
+		t.Errorf("Groups.PurgeDependencyProxyCache returned error: %v", err)
+	}
+
+	want := http.StatusAccepted
+	got := resp.StatusCode
+	if got != want {
+		t.Errorf("Groups.PurgeDependencyProxyCache returned %d, want %d", got, want)
+	}
+}
@@ -18,6 +18,7 @@ package gitlab
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 	"reflect"
@@ -182,17 +183,20 @@ type ListIssuesOptions struct {
 	State               *string          `url:"state,omitempty" json:"state,omitempty"`
 	Labels              *LabelOptions    `url:"labels,comma,omitempty" json:"labels,omitempty"`
 	NotLabels           *LabelOptions    `url:"not[labels],comma,omitempty" json:"not[labels],omitempty"`
+	Not                 *NotFilter       `url:"not,omitempty" json:"not,omitempty"`
 	WithLabelDetails    *bool            `url:"with_labels_details,omitempty" json:"with_labels_details,omitempty"`
 	Milestone           *string          `url:"milestone,omitempty" json:"milestone,omitempty"`
 	NotMilestone        *string          `url:"not[milestone],omitempty" json:"not[milestone],omitempty"`
 	Scope               *string          `url:"scope,omitempty" json:"scope,omitempty"`
 	AuthorID            *int             `url:"author_id,omitempty" json:"author_id,omitempty"`
 	AuthorUsername      *string          `url:"author_username,omitempty" json:"author_username,omitempty"`
+	AuthorUsernames     *[]string        `url:"author_username[],omitempty" json:"author_username[],omitempty"`
 	NotAuthorUsername   *string          `url:"not[author_username],omitempty" json:"not[author_username],omitempty"`
 	NotAuthorID         *[]int           `url:"not[author_id],omitempty" json:"not[author_id],omitempty"`
 	AssigneeID          *AssigneeIDValue `url:"assignee_id,omitempty" json:"assignee_id,omitempty"`
 	NotAssigneeID       *[]int           `url:"not[assignee_id],omitempty" json:"not[assignee_id],omitempty"`
 	AssigneeUsername    *string          `url:"assignee_username,omitempty" json:"assignee_username,omitempty"`
+	AssigneeUsernames   *[]string        `url:"assignee_username[],omitempty" json:"assignee_username[],omitempty"`
 	NotAssigneeUsername *string          `url:"not[assignee_username],omitempty" json:"not[assignee_username],omitempty"`
 	MyReactionEmoji     *string          `url:"my_reaction_emoji,omitempty" json:"my_reaction_emoji,omitempty"`
 	NotMyReactionEmoji  *[]string        `url:"not[my_reaction_emoji],omitempty" json:"not[my_reaction_emoji],omitempty"`
@@ -213,11 +217,40 @@ type ListIssuesOptions struct {
 	IterationID         *int             `url:"iteration_id,omitempty" json:"iteration_id,omitempty"`
 }
 
+// validate checks the user filters of the options.
+func (opt *ListIssuesOptions) validate() error {
+	if opt == nil {
+		return nil
+	}
+	return validateUserFilters(
+		opt.AssigneeID != nil,
+		opt.AssigneeUsername != nil || opt.AssigneeUsernames != nil,
+		opt.AuthorID != nil,
+		opt.AuthorUsername != nil || opt.AuthorUsernames != nil,
+	)
+}
+
+// validateUserFilters returns an error when both an ID and a username filter
+// are set for the same role, as GitLab only accepts one of them.
+func validateUserFilters(assigneeID, assigneeUsername, authorID, authorUsername bool) error {
+	if assigneeID && assigneeUsername {
+		return errors.New("assignee ID and assignee username filters are mutually exclusive")
+	}
+	if authorID && authorUsername {
+		return errors.New("author ID and author username filters are mutually exclusive")
+	}
+	return nil
+}
+
 // ListIssues gets all issues created by authenticated user. This function
 // takes pagination parameters page and per_page to restrict the list of issues.
 //
 // GitLab API docs: https://docs.gitlab.com/ee/api/issues.html#list-issues
 func (s *IssuesService) ListIssues(opt *ListIssuesOptions, options ...RequestOptionFunc) ([]*Issue, *Response, error) {
+	if err := opt.validate(); err != nil {
+		return nil, nil, err
+	}
+
 	req, err := s.client.NewRequest(http.MethodGet, "issues", opt, options)
 	if err != nil {
 		return nil, nil, err
@@ -240,6 +273,7 @@ type ListGroupIssuesOptions struct {
 	State             *string       `url:"state,omitempty" json:"state,omitempty"`
 	Labels            *LabelOptions `url:"labels,comma,omitempty" json:"labels,omitempty"`
 	NotLabels         *LabelOptions `url:"not[labels],comma,omitempty" json:"not[labels],omitempty"`
+	Not               *NotFilter    `url:"not,omitempty" json:"not,omitempty"`
 	WithLabelDetails  *bool         `url:"with_labels_details,omitempty" json:"with_labels_details,omitempty"`
 	IIDs              *[]int        `url:"iids[],omitempty" json:"iids,omitempty"`
 	Milestone         *string       `url:"milestone,omitempty" json:"milestone,omitempty"`
@@ -248,11 +282,13 @@ type ListGroupIssuesOptions struct {
 	AuthorID          *int          `url:"author_id,omitempty" json:"author_id,omitempty"`
 	NotAuthorID       *[]int        `url:"not[author_id],omitempty" json:"not[author_id],omitempty"`
 	AuthorUsername    *string       `url:"author_username,omitempty" json:"author_username,omitempty"`
+	AuthorUsernames   *[]string     `url:"author_username[],omitempty" json:"author_username[],omitempty"`
 	NotAuthorUsername *string       `url:"not[author_username],omitempty" json:"not[author_username],omitempty"`
 
 	AssigneeID          *AssigneeIDValue `url:"assignee_id,omitempty" json:"assignee_id,omitempty"`
 	NotAssigneeID       *[]int           `url:"not[assignee_id],omitempty" json:"not[assignee_id],omitempty"`
 	AssigneeUsername    *string          `url:"assignee_username,omitempty" json:"assignee_username,omitempty"`
+	AssigneeUsernames   *[]string        `url:"assignee_username[],omitempty" json:"assignee_username[],omitempty"`
 	NotAssigneeUsername *string          `url:"not[assignee_username],omitempty" json:"not[assignee_username],omitempty"`
 	MyReactionEmoji     *string          `url:"my_reaction_emoji,omitempty" json:"my_reaction_emoji,omitempty"`
 	NotMyReactionEmoji  *[]string        `url:"not[my_reaction_emoji],omitempty" json:"not[my_reaction_emoji],omitempty"`
@@ -271,6 +307,19 @@ type ListGroupIssuesOptions struct {
 	IterationID         *int             `url:"iteration_id,omitempty" json:"iteration_id,omitempty"`
 }
 
+// validate checks the user filters of the options.
+func (opt *ListGroupIssuesOptions) validate() error {
+	if opt == nil {
+		return nil
+	}
+	return validateUserFilters(
+		opt.AssigneeID != nil,
+		opt.AssigneeUsername != nil || opt.AssigneeUsernames != nil,
+		opt.AuthorID != nil,
+		opt.AuthorUsername != nil || opt.AuthorUsernames != nil,
+	)
+}
+
 // ListGroupIssues gets a list of group issues. This function accepts
 // pagination parameters page and per_page to return the list of group issues.
 //
@@ -280,6 +329,9 @@ func (s *IssuesService) ListGroupIssues(pid interface{}, opt *ListGroupIssuesOpt
 	if err != nil {
 		return nil, nil, err
 	}
+	if err := opt.validate(); err != nil {
+		return nil, nil, err
+	}
 	u := fmt.Sprintf("groups/%s/issues", PathEscape(group))
 
 	req, err := s.client.NewRequest(http.MethodGet, u, opt, options)
@@ -305,17 +357,20 @@ type ListProjectIssuesOptions struct {
 	State               *string          `url:"state,omitempty" json:"state,omitempty"`
 	Labels              *LabelOptions    `url:"labels,comma,omitempty" json:"labels,omitempty"`
 	NotLabels           *LabelOptions    `url:"not[labels],comma,omitempty" json:"not[labels],omitempty"`
+	Not                 *NotFilter       `url:"not,omitempty" json:"not,omitempty"`
 	WithLabelDetails    *bool            `url:"with_labels_details,omitempty" json:"with_labels_details,omitempty"`
 	Milestone           *string          `url:"milestone,omitempty" json:"milestone,omitempty"`
 	NotMilestone        *string          `url:"not[milestone],omitempty" json:"not[milestone],omitempty"`
 	Scope               *string          `url:"scope,omitempty" json:"scope,omitempty"`
 	AuthorID            *int             `url:"author_id,omitempty" json:"author_id,omitempty"`
 	AuthorUsername      *string          `url:"author_username,omitempty" json:"author_username,omitempty"`
+	AuthorUsernames     *[]string        `url:"author_username[],omitempty" json:"author_username[],omitempty"`
 	NotAuthorUsername   *string          `url:"not[author_username],omitempty" json:"not[author_username],omitempty"`
 	NotAuthorID         *[]int           `url:"not[author_id],omitempty" json:"not[author_id],omitempty"`
 	AssigneeID          *AssigneeIDValue `url:"assignee_id,omitempty" json:"assignee_id,omitempty"`
 	NotAssigneeID       *[]int           `url:"not[assignee_id],omitempty" json:"not[assignee_id],omitempty"`
 	AssigneeUsername    *string          `url:"assignee_username,omitempty" json:"assignee_username,omitempty"`
+	AssigneeUsernames   *[]string        `url:"assignee_username[],omitempty" json:"assignee_username[],omitempty"`
 	NotAssigneeUsername *string          `url:"not[assignee_username],omitempty" json:"not[assignee_username],omitempty"`
 	MyReactionEmoji     *string          `url:"my_reaction_emoji,omitempty" json:"my_reaction_emoji,omitempty"`
 	NotMyReactionEmoji  *[]string        `url:"not[my_reaction_emoji],omitempty" json:"not[my_reaction_emoji],omitempty"`
@@ -334,6 +389,19 @@ type ListProjectIssuesOptions struct {
 	IterationID         *int             `url:"iteration_id,omitempty" json:"iteration_id,omitempty"`
 }
 
+// validate checks the user filters of the options.
+func (opt *ListProjectIssuesOptions) validate() error {
+	if opt == nil {
+		return nil
+	}
+	return validateUserFilters(
+		opt.AssigneeID != nil,
+		opt.AssigneeUsername != nil || opt.AssigneeUsernames != nil,
+		opt.AuthorID != nil,
+		opt.AuthorUsername != nil || opt.AuthorUsernames != nil,
+	)
+}
+
 // ListProjectIssues gets a list of project issues. This function accepts
 // pagination parameters page and per_page to return the list of project issues.
 //
@@ -343,6 +411,9 @@ func (s *IssuesService) ListProjectIssues(pid interface{}, opt *ListProjectIssue
 	if err != nil {
 		return nil, nil, err
 	}
+	if err := opt.validate(); err != nil {
+		return nil, nil, err
+	}
 	u := fmt.Sprintf("projects/%s/issues", PathEscape(project))
 
 	req, err := s.client.NewRequest(http.MethodGet, u, opt, options)
@@ -403,6 +474,20 @@ func (s *IssuesService) GetIssue(pid interface{}, issue int, options ...RequestO
 	return i, resp, nil
 }
 
+// GetIssueTaskProgress gets the fraction of completed tasks of the task list
+// in the description of a project issue, between 0 and 1. It returns 0 for an
+// issue without tasks.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/api/issues.html#single-project-issue
+func (s *IssuesService) GetIssueTaskProgress(pid interface{}, issue int, options ...RequestOptionFunc) (float64, *Response, error) {
+	i, resp, err := s.GetIssue(pid, issue, options...)
+	if err != nil {
+		return 0, resp, err
+	}
+
+	return i.TaskCompletionStatus.Progress(), resp, nil
+}
+
 // CreateIssueOptions represents the available CreateIssue() options.
 //
 // GitLab API docs: https://docs.gitlab.com/ee/api/issues.html#new-issue
@@ -493,6 +578,40 @@ func (s *IssuesService) UpdateIssue(pid interface{}, issue int, opt *UpdateIssue
 	return i, resp, nil
 }
 
+// bulkIssueWorkers bounds the number of issues updated concurrently by
+// CloseIssues and ReopenIssues.
+const bulkIssueWorkers = 4
+
+// CloseIssues closes the given project issues. The returned issues and errors
+// are aligned with the given IIDs, so a failure for one issue does not abort
+// the others.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/api/issues.html#edit-issues
+func (s *IssuesService) CloseIssues(pid interface{}, iids []int, options ...RequestOptionFunc) ([]*Issue, []error) {
+	return s.updateIssuesState(pid, iids, "close", options...)
+}
+
+// ReopenIssues reopens the given project issues. The returned issues and
+// errors are aligned with the given IIDs, so a failure for one issue does not
+// abort the others.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/api/issues.html#edit-issues
+func (s *IssuesService) ReopenIssues(pid interface{}, iids []int, options ...RequestOptionFunc) ([]*Issue, []error) {
+	return s.updateIssuesState(pid, iids, "reopen", options...)
+}
+
+func (s *IssuesService) updateIssuesState(pid interface{}, iids []int, event string, options ...RequestOptionFunc) ([]*Issue, []error) {
+	issues := make([]*Issue, len(iids))
+	errs := make([]error, len(iids))
+
+	forEachBounded(len(iids), bulkIssueWorkers, func(i int) {
+		opt := &UpdateIssueOptions{StateEvent: Ptr(event)}
+		issues[i], _, errs[i] = s.UpdateIssue(pid, iids[i], opt, options...)
+	})
+
+	return issues, errs
+}
+
 // DeleteIssue deletes a single project issue.
 //
 // GitLab API docs: https://docs.gitlab.com/ee/api/issues.html#delete-an-issue
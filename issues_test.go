@@ -51,6 +51,44 @@ func TestGetIssue(t *testing.T) {
 	}
 }
 
+func TestGetIssueTaskProgress(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/1/issues/5", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{"id":1,"iid":5,"task_completion_status":{"count":5,"completed_count":2}}`)
+	})
+	mux.HandleFunc("/api/v4/projects/1/issues/6", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{"id":2,"iid":6,"task_completion_status":{"count":0,"completed_count":0}}`)
+	})
+
+	issue, _, err := client.Issues.GetIssue(1, 5)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := &TasksCompletionStatus{Count: 5, CompletedCount: 2}
+	if !reflect.DeepEqual(want, issue.TaskCompletionStatus) {
+		t.Errorf("Issues.GetIssue returned task completion status %+v, want %+v", issue.TaskCompletionStatus, want)
+	}
+
+	progress, _, err := client.Issues.GetIssueTaskProgress(1, 5)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if progress != 0.4 {
+		t.Errorf("Issues.GetIssueTaskProgress returned %v, want 0.4", progress)
+	}
+
+	progress, _, err = client.Issues.GetIssueTaskProgress(1, 6)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if progress != 0 {
+		t.Errorf("Issues.GetIssueTaskProgress returned %v for an issue without tasks, want 0", progress)
+	}
+}
+
 func TestGetIssueByID(t *testing.T) {
 	mux, client := setup(t)
 
@@ -223,6 +261,127 @@ func TestListIssues(t *testing.T) {
 	}
 }
 
+func TestListIssuesByAssigneeUsername(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/1/issues", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testURL(t, r, "/api/v4/projects/1/issues?assignee_username%5B%5D=alice&assignee_username%5B%5D=bob")
+		fmt.Fprint(w, `[{"id":1,"assignees":[{"id":1,"username":"alice"}]}]`)
+	})
+
+	issues, _, err := client.Issues.ListProjectIssues(1, &ListProjectIssuesOptions{
+		AssigneeUsernames: &[]string{"alice", "bob"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []*Issue{{ID: 1, Assignees: []*IssueAssignee{{ID: 1, Username: "alice"}}}}
+	if !reflect.DeepEqual(want, issues) {
+		t.Errorf("Issues.ListProjectIssues returned %+v, want %+v", issues, want)
+	}
+}
+
+func TestListIssuesExcludingLabel(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/1/issues", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testParams(t, r, "labels=bug&not%5Blabels%5D=wontfix&not%5Bmilestone%5D=v1.0")
+		fmt.Fprint(w, `[{"id":1,"labels":["bug"]}]`)
+	})
+
+	issues, _, err := client.Issues.ListProjectIssues(1, &ListProjectIssuesOptions{
+		Labels:       &LabelOptions{"bug"},
+		NotLabels:    &LabelOptions{"wontfix"},
+		NotMilestone: Ptr("v1.0"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []*Issue{{ID: 1, Labels: Labels{"bug"}}}
+	if !reflect.DeepEqual(want, issues) {
+		t.Errorf("Issues.ListProjectIssues returned %+v, want %+v", issues, want)
+	}
+}
+
+func TestListIssuesNotFilter(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/1/issues", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testParams(t, r, "labels=bug&not%5Bassignee_id%5D=3&not%5Bauthor_id%5D=2&not%5Blabels%5D=wontfix%2Cduplicate&not%5Bmilestone%5D=v1.0")
+		fmt.Fprint(w, `[{"id":1,"labels":["bug"]}]`)
+	})
+
+	issues, _, err := client.Issues.ListProjectIssues(1, &ListProjectIssuesOptions{
+		Labels: &LabelOptions{"bug"},
+		Not: &NotFilter{
+			Labels:     &LabelOptions{"wontfix", "duplicate"},
+			Milestone:  Ptr("v1.0"),
+			AuthorID:   &[]int{2},
+			AssigneeID: &[]int{3},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []*Issue{{ID: 1, Labels: Labels{"bug"}}}
+	if !reflect.DeepEqual(want, issues) {
+		t.Errorf("Issues.ListProjectIssues returned %+v, want %+v", issues, want)
+	}
+}
+
+func TestListIssuesUserFiltersMutuallyExclusive(t *testing.T) {
+	_, client := setup(t)
+
+	_, resp, err := client.Issues.ListIssues(&ListIssuesOptions{
+		AssigneeID:        AssigneeID(2),
+		AssigneeUsernames: &[]string{"alice"},
+	})
+	assert.EqualError(t, err, "assignee ID and assignee username filters are mutually exclusive")
+	assert.Nil(t, resp)
+
+	_, resp, err = client.Issues.ListGroupIssues(1, &ListGroupIssuesOptions{
+		AuthorID:       Ptr(1),
+		AuthorUsername: Ptr("alice"),
+	})
+	assert.EqualError(t, err, "author ID and author username filters are mutually exclusive")
+	assert.Nil(t, resp)
+
+	_, resp, err = client.Issues.ListProjectIssues(1, &ListProjectIssuesOptions{
+		AuthorID:        Ptr(1),
+		AuthorUsernames: &[]string{"alice"},
+	})
+	assert.EqualError(t, err, "author ID and author username filters are mutually exclusive")
+	assert.Nil(t, resp)
+}
+
+func TestListIssuesByAuthorUsernames(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/1/issues", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testURL(t, r, "/api/v4/projects/1/issues?author_username%5B%5D=alice&author_username%5B%5D=bob")
+		fmt.Fprint(w, `[{"id":1,"author":{"id":1,"username":"alice"}}]`)
+	})
+
+	issues, _, err := client.Issues.ListProjectIssues(1, &ListProjectIssuesOptions{
+		AuthorUsernames: &[]string{"alice", "bob"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []*Issue{{ID: 1, Author: &IssueAuthor{ID: 1, Username: "alice"}}}
+	if !reflect.DeepEqual(want, issues) {
+		t.Errorf("Issues.ListProjectIssues returned %+v, want %+v", issues, want)
+	}
+}
+
 func TestListIssuesWithLabelDetails(t *testing.T) {
 	mux, client := setup(t)
 
@@ -959,3 +1118,49 @@ func TestGetIssueGroupMilestone(t *testing.T) {
 		t.Errorf("Issues.GetIssue returned %+v, want %+v", issue, want)
 	}
 }
+
+func TestCloseIssues(t *testing.T) {
+	mux, client := setup(t)
+
+	for _, iid := range []int{1, 3} {
+		iid := iid
+		mux.HandleFunc(fmt.Sprintf("/api/v4/projects/1/issues/%d", iid), func(w http.ResponseWriter, r *http.Request) {
+			testMethod(t, r, http.MethodPut)
+			testBody(t, r, `{"state_event":"close"}`)
+			fmt.Fprintf(w, `{"id":%d,"iid":%d,"state":"closed"}`, iid+100, iid)
+		})
+	}
+	mux.HandleFunc("/api/v4/projects/1/issues/2", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+		fmt.Fprint(w, `{"message":"403 Forbidden"}`)
+	})
+
+	issues, errs := client.Issues.CloseIssues(1, []int{1, 2, 3})
+
+	assert.Len(t, issues, 3)
+	assert.Len(t, errs, 3)
+
+	assert.NoError(t, errs[0])
+	assert.Equal(t, 1, issues[0].IID)
+	assert.Equal(t, "closed", issues[0].State)
+
+	assert.Error(t, errs[1])
+	assert.Nil(t, issues[1])
+
+	assert.NoError(t, errs[2])
+	assert.Equal(t, 3, issues[2].IID)
+}
+
+func TestReopenIssues(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/1/issues/4", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPut)
+		testBody(t, r, `{"state_event":"reopen"}`)
+		fmt.Fprint(w, `{"id":104,"iid":4,"state":"opened"}`)
+	})
+
+	issues, errs := client.Issues.ReopenIssues(1, []int{4})
+	assert.NoError(t, errs[0])
+	assert.Equal(t, "opened", issues[0].State)
+}
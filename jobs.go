@@ -20,6 +20,8 @@ import (
 	"bytes"
 	"fmt"
 	"net/http"
+	"strconv"
+	"strings"
 	"time"
 )
 
@@ -583,3 +585,80 @@ func (s *JobsService) DeleteProjectArtifacts(pid interface{}, options ...Request
 
 	return s.client.Do(req, nil)
 }
+
+// AppendTrace appends content to the trace of a running job, as runners do
+// while a job runs. The content is written at startOffset, which must equal
+// the current length of the trace. It returns the length of the trace after
+// appending, as reported by GitLab in the Range header of the response. Empty
+// content is not sent, and startOffset is returned as is.
+//
+// Like all runner job endpoints, it must be authenticated with the token of
+// the job, for example with a client created by NewJobClient.
+//
+// GitLab API source:
+// https://gitlab.com/gitlab-org/gitlab/-/blob/master/lib/api/ci/runner.rb
+func (s *JobsService) AppendTrace(jobID int, content []byte, startOffset int, options ...RequestOptionFunc) (int, *Response, error) {
+	// An empty Content-Range is invalid, so there is nothing to send.
+	if len(content) == 0 {
+		return startOffset, nil, nil
+	}
+
+	u := fmt.Sprintf("jobs/%d/trace", jobID)
+
+	req, err := s.client.NewRequest(http.MethodPatch, u, nil, options)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	if err := req.SetBody(content); err != nil {
+		return 0, nil, err
+	}
+	req.Header.Set("Content-Type", "text/plain")
+	req.Header.Set("Content-Range", fmt.Sprintf("%d-%d", startOffset, startOffset+len(content)-1))
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return 0, resp, err
+	}
+
+	// The Range header holds the range of the whole trace, like "0-1024".
+	_, end, ok := strings.Cut(resp.Header.Get("Range"), "-")
+	if !ok {
+		return 0, resp, fmt.Errorf("invalid Range header %q", resp.Header.Get("Range"))
+	}
+	length, err := strconv.Atoi(end)
+	if err != nil {
+		return 0, resp, fmt.Errorf("invalid Range header %q", resp.Header.Get("Range"))
+	}
+
+	return length, resp, nil
+}
+
+// UpdateJobOptions represents the available UpdateJob() options.
+//
+// GitLab API source:
+// https://gitlab.com/gitlab-org/gitlab/-/blob/master/lib/api/ci/runner.rb
+type UpdateJobOptions struct {
+	Token         *string          `url:"token,omitempty" json:"token,omitempty"`
+	State         *BuildStateValue `url:"state,omitempty" json:"state,omitempty"`
+	FailureReason *string          `url:"failure_reason,omitempty" json:"failure_reason,omitempty"`
+	ExitCode      *int             `url:"exit_code,omitempty" json:"exit_code,omitempty"`
+	Checksum      *string          `url:"checksum,omitempty" json:"checksum,omitempty"`
+}
+
+// UpdateJob updates the state of a job, as runners do when a job finishes.
+// State can be one of: Running, Success, Failed. The job token is passed
+// either as Token or through a client created by NewJobClient.
+//
+// GitLab API source:
+// https://gitlab.com/gitlab-org/gitlab/-/blob/master/lib/api/ci/runner.rb
+func (s *JobsService) UpdateJob(jobID int, opt *UpdateJobOptions, options ...RequestOptionFunc) (*Response, error) {
+	u := fmt.Sprintf("jobs/%d", jobID)
+
+	req, err := s.client.NewRequest(http.MethodPut, u, opt, options)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(req, nil)
+}
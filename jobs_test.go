@@ -20,10 +20,12 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/http/httptest"
 	"reflect"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestListPipelineJobs(t *testing.T) {
@@ -218,3 +220,76 @@ func TestDownloadSingleArtifactsFileByTagOrBranch(t *testing.T) {
 		t.Errorf("Jobs.DownloadSingleArtifactsFileByTagOrBranch returned returned status code  %+v, want %+v", resp.StatusCode, wantCode)
 	}
 }
+
+func TestAppendTrace(t *testing.T) {
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	mux.HandleFunc("/api/v4/jobs/7/trace", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPatch)
+		assert.Equal(t, "job-token", r.Header.Get("JOB-TOKEN"))
+		assert.Equal(t, "text/plain", r.Header.Get("Content-Type"))
+		assert.Equal(t, "10-15", r.Header.Get("Content-Range"))
+		body, err := io.ReadAll(r.Body)
+		assert.NoError(t, err)
+		assert.Equal(t, "done!\n", string(body))
+		w.Header().Set("Range", "0-16")
+		w.WriteHeader(http.StatusAccepted)
+	})
+
+	client, err := NewJobClient("job-token", WithBaseURL(server.URL))
+	require.NoError(t, err)
+
+	length, resp, err := client.Jobs.AppendTrace(7, []byte("done!\n"), 10)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusAccepted, resp.StatusCode)
+	assert.Equal(t, 16, length)
+}
+
+func TestAppendTraceRangeNotSatisfiable(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/jobs/7/trace", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPatch)
+		w.Header().Set("Range", "0-4")
+		w.WriteHeader(http.StatusRequestedRangeNotSatisfiable)
+	})
+
+	length, resp, err := client.Jobs.AppendTrace(7, []byte("more"), 10)
+	require.Error(t, err)
+	assert.Equal(t, 0, length)
+	assert.Equal(t, http.StatusRequestedRangeNotSatisfiable, resp.StatusCode)
+	assert.Equal(t, "0-4", resp.Header.Get("Range"))
+}
+
+func TestAppendTraceEmpty(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/jobs/7/trace", func(w http.ResponseWriter, r *http.Request) {
+		t.Error("unexpected request to append an empty trace")
+	})
+
+	length, resp, err := client.Jobs.AppendTrace(7, nil, 10)
+	require.NoError(t, err)
+	assert.Nil(t, resp)
+	assert.Equal(t, 10, length)
+}
+
+func TestUpdateJob(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/jobs/7", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPut)
+		testBody(t, r, `{"token":"job-token","state":"failed","failure_reason":"script_failure","exit_code":1}`)
+	})
+
+	resp, err := client.Jobs.UpdateJob(7, &UpdateJobOptions{
+		Token:         Ptr("job-token"),
+		State:         Ptr(Failed),
+		FailureReason: Ptr("script_failure"),
+		ExitCode:      Ptr(1),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+}
@@ -77,26 +77,26 @@ type CreateMemberRoleOptions struct {
 	Name                       *string           `url:"name,omitempty" json:"name,omitempty"`
 	BaseAccessLevel            *AccessLevelValue `url:"base_access_level,omitempty" json:"base_access_level,omitempty"`
 	Description                *string           `url:"description,omitempty" json:"description,omitempty"`
-	AdminCICDVariables         *bool             `url:"admin_cicd_variables" json:"admin_cicd_variables,omitempty"`
-	AdminComplianceFramework   *bool             `url:"admin_compliance_framework" json:"admin_compliance_framework,omitempty"`
-	AdminGroupMembers          *bool             `url:"admin_group_member" json:"admin_group_member,omitempty"`
+	AdminCICDVariables         *bool             `url:"admin_cicd_variables,omitempty" json:"admin_cicd_variables,omitempty"`
+	AdminComplianceFramework   *bool             `url:"admin_compliance_framework,omitempty" json:"admin_compliance_framework,omitempty"`
+	AdminGroupMembers          *bool             `url:"admin_group_member,omitempty" json:"admin_group_member,omitempty"`
 	AdminMergeRequest          *bool             `url:"admin_merge_request,omitempty" json:"admin_merge_request,omitempty"`
-	AdminPushRules             *bool             `url:"admin_push_rules" json:"admin_push_rules,omitempty"`
-	AdminTerraformState        *bool             `url:"admin_terraform_state" json:"admin_terraform_state,omitempty"`
+	AdminPushRules             *bool             `url:"admin_push_rules,omitempty" json:"admin_push_rules,omitempty"`
+	AdminTerraformState        *bool             `url:"admin_terraform_state,omitempty" json:"admin_terraform_state,omitempty"`
 	AdminVulnerability         *bool             `url:"admin_vulnerability,omitempty" json:"admin_vulnerability,omitempty"`
-	AdminWebHook               *bool             `url:"admin_web_hook" json:"admin_web_hook,omitempty"`
-	ArchiveProject             *bool             `url:"archive_project" json:"archive_project,omitempty"`
-	ManageDeployTokens         *bool             `url:"manage_deploy_tokens" json:"manage_deploy_tokens,omitempty"`
-	ManageGroupAccesToken      *bool             `url:"manage_group_access_tokens" json:"manage_group_access_tokens,omitempty"`
-	ManageMergeRequestSettings *bool             `url:"manage_merge_request_settings" json:"manage_merge_request_settings,omitempty"`
-	ManageProjectAccessToken   *bool             `url:"manage_project_access_tokens" json:"manage_project_access_tokens,omitempty"`
-	ManageSecurityPolicyLink   *bool             `url:"manage_security_policy_link" json:"manage_security_policy_link,omitempty"`
+	AdminWebHook               *bool             `url:"admin_web_hook,omitempty" json:"admin_web_hook,omitempty"`
+	ArchiveProject             *bool             `url:"archive_project,omitempty" json:"archive_project,omitempty"`
+	ManageDeployTokens         *bool             `url:"manage_deploy_tokens,omitempty" json:"manage_deploy_tokens,omitempty"`
+	ManageGroupAccesToken      *bool             `url:"manage_group_access_tokens,omitempty" json:"manage_group_access_tokens,omitempty"`
+	ManageMergeRequestSettings *bool             `url:"manage_merge_request_settings,omitempty" json:"manage_merge_request_settings,omitempty"`
+	ManageProjectAccessToken   *bool             `url:"manage_project_access_tokens,omitempty" json:"manage_project_access_tokens,omitempty"`
+	ManageSecurityPolicyLink   *bool             `url:"manage_security_policy_link,omitempty" json:"manage_security_policy_link,omitempty"`
 	ReadCode                   *bool             `url:"read_code,omitempty" json:"read_code,omitempty"`
-	ReadRunners                *bool             `url:"read_runners" json:"read_runners,omitempty"`
+	ReadRunners                *bool             `url:"read_runners,omitempty" json:"read_runners,omitempty"`
 	ReadDependency             *bool             `url:"read_dependency,omitempty" json:"read_dependency,omitempty"`
 	ReadVulnerability          *bool             `url:"read_vulnerability,omitempty" json:"read_vulnerability,omitempty"`
-	RemoveGroup                *bool             `url:"remove_group" json:"remove_group,omitempty"`
-	RemoveProject              *bool             `url:"remove_project" json:"remove_project,omitempty"`
+	RemoveGroup                *bool             `url:"remove_group,omitempty" json:"remove_group,omitempty"`
+	RemoveProject              *bool             `url:"remove_project,omitempty" json:"remove_project,omitempty"`
 }
 
 // CreateMemberRole creates a new member role for a specified group.
@@ -0,0 +1,182 @@
+//
+// Copyright 2021, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package gitlab
+
+import (
+	"fmt"
+	"net/http"
+)
+
+// MergeRequestApprovalSetting represents a single merge request approval
+// setting of a group or project. InheritedFrom is empty when the value is set
+// on the group or project itself, and otherwise names the level it is
+// inherited from, like "group" or "instance".
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/merge_request_approval_settings.html
+type MergeRequestApprovalSetting struct {
+	Value         bool   `json:"value"`
+	Locked        bool   `json:"locked"`
+	InheritedFrom string `json:"inherited_from"`
+}
+
+// MergeRequestApprovalSettings represents the merge request approval settings
+// of a group or project.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/merge_request_approval_settings.html
+type MergeRequestApprovalSettings struct {
+	AllowAuthorApproval                         MergeRequestApprovalSetting `json:"allow_author_approval"`
+	AllowCommitterApproval                      MergeRequestApprovalSetting `json:"allow_committer_approval"`
+	AllowOverridesToApproverListPerMergeRequest MergeRequestApprovalSetting `json:"allow_overrides_to_approver_list_per_merge_request"`
+	RetainApprovalsOnPush                       MergeRequestApprovalSetting `json:"retain_approvals_on_push"`
+	SelectiveCodeOwnerRemovals                  MergeRequestApprovalSetting `json:"selective_code_owner_removals"`
+	RequirePasswordToApprove                    MergeRequestApprovalSetting `json:"require_password_to_approve"`
+}
+
+func (s MergeRequestApprovalSettings) String() string {
+	return Stringify(s)
+}
+
+// GetGroupMergeRequestApprovalSettings gets the merge request approval
+// settings of a group.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/merge_request_approval_settings.html#get-group-mr-approval-settings
+func (s *GroupsService) GetGroupMergeRequestApprovalSettings(gid interface{}, options ...RequestOptionFunc) (*MergeRequestApprovalSettings, *Response, error) {
+	group, err := parseID(gid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("groups/%s/merge_request_approval_setting", PathEscape(group))
+
+	req, err := s.client.NewRequest(http.MethodGet, u, nil, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	as := new(MergeRequestApprovalSettings)
+	resp, err := s.client.Do(req, as)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return as, resp, nil
+}
+
+// GetProjectMergeRequestApprovalSettings gets the merge request approval
+// settings of a project. GitLab resolves the values against the settings of
+// the group and instance, and reports where each value is inherited from.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/merge_request_approval_settings.html#get-project-mr-approval-settings
+func (s *ProjectsService) GetProjectMergeRequestApprovalSettings(pid interface{}, options ...RequestOptionFunc) (*MergeRequestApprovalSettings, *Response, error) {
+	project, err := parseID(pid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("projects/%s/merge_request_approval_setting", PathEscape(project))
+
+	req, err := s.client.NewRequest(http.MethodGet, u, nil, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	as := new(MergeRequestApprovalSettings)
+	resp, err := s.client.Do(req, as)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return as, resp, nil
+}
+
+// EffectiveApprovalSetting represents the resolved value of a project
+// approval setting. Inherited is true when the value is not set on the project
+// itself; Source then holds the level it comes from, like "group" or
+// "instance", and is "project" otherwise.
+type EffectiveApprovalSetting struct {
+	Value     bool   `json:"value"`
+	Inherited bool   `json:"inherited"`
+	Locked    bool   `json:"locked"`
+	Source    string `json:"source"`
+}
+
+// EffectiveApprovalSettings represents the approval settings that apply to
+// the merge requests of a project, after resolving the settings of its group
+// against the project overrides.
+type EffectiveApprovalSettings struct {
+	ApprovalsBeforeMerge                      int                      `json:"approvals_before_merge"`
+	ResetApprovalsOnPush                      EffectiveApprovalSetting `json:"reset_approvals_on_push"`
+	DisableOverridingApproversPerMergeRequest EffectiveApprovalSetting `json:"disable_overriding_approvers_per_merge_request"`
+	MergeRequestsAuthorApproval               EffectiveApprovalSetting `json:"merge_requests_author_approval"`
+	MergeRequestsDisableCommittersApproval    EffectiveApprovalSetting `json:"merge_requests_disable_committers_approval"`
+	RequirePasswordToApprove                  EffectiveApprovalSetting `json:"require_password_to_approve"`
+	SelectiveCodeOwnerRemovals                EffectiveApprovalSetting `json:"selective_code_owner_removals"`
+}
+
+func (s EffectiveApprovalSettings) String() string {
+	return Stringify(s)
+}
+
+// GetEffectiveApprovalSettings resolves the approval settings that apply to
+// the merge requests of a project. The values, and whether they are locked or
+// inherited, come from the project merge request approval settings, which
+// GitLab already resolves against the group and instance. The number of
+// required approvals comes from the project approval configuration.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/merge_request_approval_settings.html#get-project-mr-approval-settings
+func (s *ProjectsService) GetEffectiveApprovalSettings(pid interface{}, options ...RequestOptionFunc) (*EffectiveApprovalSettings, *Response, error) {
+	pa, resp, err := s.GetApprovalConfiguration(pid, options...)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	as, resp, err := s.GetProjectMergeRequestApprovalSettings(pid, options...)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	// resolve converts an approval setting into its effective value. Some
+	// approval settings are phrased as the opposite of the project setting,
+	// which is what invert is for.
+	resolve := func(setting MergeRequestApprovalSetting, invert bool) EffectiveApprovalSetting {
+		e := EffectiveApprovalSetting{
+			Value:     setting.Value != invert,
+			Inherited: setting.InheritedFrom != "",
+			Locked:    setting.Locked,
+			Source:    setting.InheritedFrom,
+		}
+		if e.Source == "" {
+			e.Source = "project"
+		}
+		return e
+	}
+
+	es := &EffectiveApprovalSettings{
+		ApprovalsBeforeMerge:                      pa.ApprovalsBeforeMerge,
+		ResetApprovalsOnPush:                      resolve(as.RetainApprovalsOnPush, true),
+		DisableOverridingApproversPerMergeRequest: resolve(as.AllowOverridesToApproverListPerMergeRequest, true),
+		MergeRequestsAuthorApproval:               resolve(as.AllowAuthorApproval, false),
+		MergeRequestsDisableCommittersApproval:    resolve(as.AllowCommitterApproval, true),
+		RequirePasswordToApprove:                  resolve(as.RequirePasswordToApprove, false),
+		SelectiveCodeOwnerRemovals:                resolve(as.SelectiveCodeOwnerRemovals, false),
+	}
+
+	return es, resp, nil
+}
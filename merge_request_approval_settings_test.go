@@ -0,0 +1,72 @@
+//
+// Copyright 2021, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package gitlab
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetEffectiveApprovalSettings(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/1/approvals", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{
+			"approvals_before_merge": 2,
+			"reset_approvals_on_push": false,
+			"merge_requests_author_approval": false,
+			"require_password_to_approve": true
+		}`)
+	})
+	mux.HandleFunc("/api/v4/projects/1/merge_request_approval_setting", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{
+			"allow_author_approval": {"value": false, "locked": false, "inherited_from": "group"},
+			"allow_committer_approval": {"value": true, "locked": false},
+			"allow_overrides_to_approver_list_per_merge_request": {"value": true, "locked": false},
+			"retain_approvals_on_push": {"value": true, "locked": false},
+			"selective_code_owner_removals": {"value": false, "locked": false},
+			"require_password_to_approve": {"value": true, "locked": true, "inherited_from": "instance"}
+		}`)
+	})
+
+	es, _, err := client.Projects.GetEffectiveApprovalSettings(1)
+	require.NoError(t, err)
+
+	assert.Equal(t, 2, es.ApprovalsBeforeMerge)
+	assert.Equal(t, EffectiveApprovalSetting{Value: false, Source: "project"}, es.ResetApprovalsOnPush)
+	assert.Equal(t, EffectiveApprovalSetting{Value: false, Inherited: true, Source: "group"}, es.MergeRequestsAuthorApproval)
+	assert.Equal(t, EffectiveApprovalSetting{Value: true, Inherited: true, Locked: true, Source: "instance"}, es.RequirePasswordToApprove)
+}
+
+func TestGetProjectMergeRequestApprovalSettings(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/1/merge_request_approval_setting", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{"retain_approvals_on_push": {"value": false, "locked": true, "inherited_from": "group"}}`)
+	})
+
+	as, _, err := client.Projects.GetProjectMergeRequestApprovalSettings(1)
+	require.NoError(t, err)
+	assert.Equal(t, MergeRequestApprovalSetting{Value: false, Locked: true, InheritedFrom: "group"}, as.RetainApprovalsOnPush)
+}
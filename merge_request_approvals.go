@@ -17,6 +17,7 @@
 package gitlab
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"time"
@@ -438,3 +439,185 @@ func (s *MergeRequestApprovalsService) DeleteApprovalRule(pid interface{}, merge
 
 	return s.client.Do(req, nil)
 }
+
+// MergeRequestApprovability represents whether a merge request can be merged
+// from an approvals point of view, and if not, why not.
+type MergeRequestApprovability struct {
+	Approvable            bool
+	ApprovalsLeft         int
+	UnresolvedDiscussions int
+	PipelineBlocking      bool
+	Reasons               []string
+}
+
+func (m MergeRequestApprovability) String() string {
+	return Stringify(m)
+}
+
+// IsMergeRequestApprovable combines the approvals, the unresolved discussions
+// and the detailed merge status of a merge request to tell whether it can be
+// merged, and if not, which reasons are blocking it.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/merge_requests.html#merge-status
+func (s *MergeRequestApprovalsService) IsMergeRequestApprovable(ctx context.Context, pid interface{}, mr int, options ...RequestOptionFunc) (*MergeRequestApprovability, *Response, error) {
+	options = append([]RequestOptionFunc{WithContext(ctx)}, options...)
+
+	m, resp, err := s.client.MergeRequests.GetMergeRequest(pid, mr, nil, options...)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	approvals, resp, err := s.GetConfiguration(pid, mr, options...)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	a := &MergeRequestApprovability{ApprovalsLeft: approvals.ApprovalsLeft}
+
+	opt := &ListMergeRequestDiscussionsOptions{PerPage: 100}
+	for {
+		ds, resp, err := s.client.Discussions.ListMergeRequestDiscussions(pid, mr, opt, options...)
+		if err != nil {
+			return nil, resp, err
+		}
+		for _, d := range ds {
+			for _, n := range d.Notes {
+				if n.Resolvable && !n.Resolved {
+					a.UnresolvedDiscussions++
+					break
+				}
+			}
+		}
+		if resp.NextPage == 0 {
+			break
+		}
+		opt.Page = resp.NextPage
+	}
+
+	if a.ApprovalsLeft > 0 {
+		a.Reasons = append(a.Reasons, fmt.Sprintf("%d approval(s) still required", a.ApprovalsLeft))
+	}
+	if a.UnresolvedDiscussions > 0 {
+		a.Reasons = append(a.Reasons, fmt.Sprintf("%d unresolved discussion(s)", a.UnresolvedDiscussions))
+	}
+
+	switch m.DetailedMergeStatus {
+	case DetailedMergeStatusMergeable:
+	case DetailedMergeStatusNotApproved, DetailedMergeStatusDiscussionsNotResolved:
+		// Already covered by the approvals and discussions above.
+	case DetailedMergeStatusCIMustPass, DetailedMergeStatusCIStillRunning:
+		a.PipelineBlocking = true
+		a.Reasons = append(a.Reasons, "pipeline must succeed")
+	default:
+		a.Reasons = append(a.Reasons, fmt.Sprintf("merge status is %s", m.DetailedMergeStatus))
+	}
+
+	a.Approvable = len(a.Reasons) == 0
+
+	return a, resp, nil
+}
+
+// reviewerThroughputWorkers bounds the number of merge requests whose
+// approvals are fetched concurrently by ReviewerThroughput.
+const reviewerThroughputWorkers = 4
+
+// ReviewerThroughputStats represents how many merged merge requests were
+// approved by a given user.
+type ReviewerThroughputStats struct {
+	UserID       int
+	Merged       int
+	Approved     int
+	ApprovedIIDs []int
+	Skipped      map[int]string
+}
+
+func (r ReviewerThroughputStats) String() string {
+	return Stringify(r)
+}
+
+// ReviewerThroughput walks the merge requests of a project that were merged
+// since the given time and counts how many of them were approved by the given
+// user. Merge requests whose approvals cannot be retrieved are skipped and
+// recorded in Skipped together with the reason.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/merge_request_approvals.html#merge-request-level-mr-approvals
+func (s *MergeRequestApprovalsService) ReviewerThroughput(ctx context.Context, pid interface{}, userID int, since time.Time, options ...RequestOptionFunc) (*ReviewerThroughputStats, *Response, error) {
+	options = append([]RequestOptionFunc{WithContext(ctx)}, options...)
+
+	var merged []*MergeRequest
+	var resp *Response
+
+	opt := &ListProjectMergeRequestsOptions{
+		ListOptions:  ListOptions{PerPage: 100},
+		State:        Ptr("merged"),
+		UpdatedAfter: &since,
+	}
+	for {
+		mrs, r, err := s.client.MergeRequests.ListProjectMergeRequests(pid, opt, options...)
+		if err != nil {
+			return nil, r, err
+		}
+		for _, mr := range mrs {
+			// A merge request can be updated after it was merged, so filter
+			// on the actual merge time as well. Merge requests without a
+			// merge time cannot be attributed to the window and are skipped.
+			if mr.MergedAt != nil && !mr.MergedAt.Before(since) {
+				merged = append(merged, mr)
+			}
+		}
+		resp = r
+
+		if r.NextPage == 0 {
+			break
+		}
+		opt.Page = r.NextPage
+	}
+
+	type result struct {
+		approved bool
+		err      error
+	}
+	results := make([]result, len(merged))
+
+	forEachBounded(len(merged), reviewerThroughputWorkers, func(i int) {
+		// Stop fetching approvals as soon as the context is done.
+		if ctx.Err() != nil {
+			return
+		}
+
+		a, _, err := s.GetConfiguration(pid, merged[i].IID, options...)
+		if err != nil {
+			results[i].err = err
+			return
+		}
+		for _, u := range a.ApprovedBy {
+			if u.User != nil && u.User.ID == userID {
+				results[i].approved = true
+				break
+			}
+		}
+	})
+
+	if err := ctx.Err(); err != nil {
+		return nil, resp, err
+	}
+
+	stats := &ReviewerThroughputStats{
+		UserID:  userID,
+		Merged:  len(merged),
+		Skipped: make(map[int]string),
+	}
+	for i, mr := range merged {
+		switch {
+		case results[i].err != nil:
+			stats.Skipped[mr.IID] = fmt.Sprintf("approvals unavailable: %v", results[i].err)
+		case results[i].approved:
+			stats.Approved++
+			stats.ApprovedIIDs = append(stats.ApprovedIIDs, mr.IID)
+		}
+	}
+
+	return stats, resp, nil
+}
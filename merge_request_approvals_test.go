@@ -17,10 +17,12 @@
 package gitlab
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"reflect"
 	"testing"
+	"time"
 )
 
 func TestGetApprovalState(t *testing.T) {
@@ -432,3 +434,126 @@ func TestCreateApprovalRules(t *testing.T) {
 		t.Errorf("MergeRequestApprovals.CreateApprovalRule returned %+v, want %+v", rule, want)
 	}
 }
+
+func TestIsMergeRequestApprovable(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/1/merge_requests/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{"id":1,"iid":1,"detailed_merge_status":"not_approved"}`)
+	})
+	mux.HandleFunc("/api/v4/projects/1/merge_requests/1/approvals", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{"id":1,"iid":1,"approvals_required":2,"approvals_left":1}`)
+	})
+	mux.HandleFunc("/api/v4/projects/1/merge_requests/1/discussions", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `[
+			{"id":"a","notes":[{"id":1,"resolvable":true,"resolved":false},{"id":2,"resolvable":true,"resolved":false}]},
+			{"id":"b","notes":[{"id":3,"resolvable":true,"resolved":true}]},
+			{"id":"c","individual_note":true,"notes":[{"id":4,"resolvable":false}]}
+		]`)
+	})
+
+	approvability, _, err := client.MergeRequestApprovals.IsMergeRequestApprovable(context.Background(), 1, 1)
+	if err != nil {
+		t.Fatalf("MergeRequestApprovals.IsMergeRequestApprovable returned error: %v", err)
+	}
+
+	want := &MergeRequestApprovability{
+		Approvable:            false,
+		ApprovalsLeft:         1,
+		UnresolvedDiscussions: 1,
+		Reasons: []string{
+			"1 approval(s) still required",
+			"1 unresolved discussion(s)",
+		},
+	}
+	if !reflect.DeepEqual(want, approvability) {
+		t.Errorf("MergeRequestApprovals.IsMergeRequestApprovable returned %+v, want %+v", approvability, want)
+	}
+}
+
+func TestIsMergeRequestApprovableMergeable(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/1/merge_requests/1", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"id":1,"iid":1,"detailed_merge_status":"mergeable"}`)
+	})
+	mux.HandleFunc("/api/v4/projects/1/merge_requests/1/approvals", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"id":1,"iid":1,"approvals_required":1,"approvals_left":0}`)
+	})
+	mux.HandleFunc("/api/v4/projects/1/merge_requests/1/discussions", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `[]`)
+	})
+
+	approvability, _, err := client.MergeRequestApprovals.IsMergeRequestApprovable(context.Background(), 1, 1)
+	if err != nil {
+		t.Fatalf("MergeRequestApprovals.IsMergeRequestApprovable returned error: %v", err)
+	}
+
+	want := &MergeRequestApprovability{Approvable: true}
+	if !reflect.DeepEqual(want, approvability) {
+		t.Errorf("MergeRequestApprovals.IsMergeRequestApprovable returned %+v, want %+v", approvability, want)
+	}
+}
+
+func TestReviewerThroughput(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/1/merge_requests", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testParams(t, r, "per_page=100&state=merged&updated_after=2024-01-01T00%3A00%3A00Z")
+		fmt.Fprint(w, `[
+			{"iid":1,"merged_at":"2024-01-02T10:00:00Z"},
+			{"iid":2,"merged_at":"2024-01-03T10:00:00Z"},
+			{"iid":3,"merged_at":"2024-01-04T10:00:00Z"},
+			{"iid":4,"merged_at":"2024-01-05T10:00:00Z"},
+			{"iid":5,"merged_at":"2023-12-20T10:00:00Z"},
+			{"iid":6}
+		]`)
+	})
+	mux.HandleFunc("/api/v4/projects/1/merge_requests/1/approvals", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"approved_by":[{"user":{"id":7,"username":"reviewer"}}]}`)
+	})
+	mux.HandleFunc("/api/v4/projects/1/merge_requests/2/approvals", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"approved_by":[{"user":{"id":8}},{"user":{"id":7}}]}`)
+	})
+	mux.HandleFunc("/api/v4/projects/1/merge_requests/3/approvals", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"approved_by":[{"user":{"id":8}}]}`)
+	})
+	mux.HandleFunc("/api/v4/projects/1/merge_requests/4/approvals", func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, `{"message":"403 Forbidden"}`, http.StatusForbidden)
+	})
+
+	since := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	stats, _, err := client.MergeRequestApprovals.ReviewerThroughput(context.Background(), 1, 7, since)
+	if err != nil {
+		t.Fatalf("MergeRequestApprovals.ReviewerThroughput returned error: %v", err)
+	}
+
+	if stats.Merged != 4 {
+		t.Errorf("expected 4 merged merge requests, got %d", stats.Merged)
+	}
+	if stats.Approved != 2 {
+		t.Errorf("expected 2 approvals, got %d", stats.Approved)
+	}
+	if !reflect.DeepEqual([]int{1, 2}, stats.ApprovedIIDs) {
+		t.Errorf("expected approved IIDs [1 2], got %v", stats.ApprovedIIDs)
+	}
+	if _, ok := stats.Skipped[4]; !ok || len(stats.Skipped) != 1 {
+		t.Errorf("expected merge request 4 to be skipped, got %v", stats.Skipped)
+	}
+}
+
+func TestReviewerThroughputCanceled(t *testing.T) {
+	_, client := setup(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, _, err := client.MergeRequestApprovals.ReviewerThroughput(ctx, 1, 7, time.Now())
+	if err == nil {
+		t.Fatal("expected an error for a canceled context")
+	}
+}
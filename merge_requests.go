@@ -17,12 +17,24 @@
 package gitlab
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
+	"sort"
+	"strings"
 	"time"
 )
 
+// ErrMergeRefUnavailable is returned when GitLab cannot compute the merge ref
+// of a merge request, for example because it has conflicts.
+var ErrMergeRefUnavailable = errors.New("the merge ref of the merge request cannot be computed")
+
+// ErrPipelineVariablesNotPermitted is returned when GitLab refuses the
+// variables passed to a new merge request pipeline.
+var ErrPipelineVariablesNotPermitted = errors.New("pipeline variables are not permitted")
+
 // MergeRequestsService handles communication with the merge requests related
 // methods of the GitLab API.
 //
@@ -36,49 +48,49 @@ type MergeRequestsService struct {
 //
 // GitLab API docs: https://docs.gitlab.com/ee/api/merge_requests.html
 type MergeRequest struct {
-	ID                        int                 `json:"id"`
-	IID                       int                 `json:"iid"`
-	TargetBranch              string              `json:"target_branch"`
-	SourceBranch              string              `json:"source_branch"`
-	ProjectID                 int                 `json:"project_id"`
-	Title                     string              `json:"title"`
-	State                     string              `json:"state"`
-	CreatedAt                 *time.Time          `json:"created_at"`
-	UpdatedAt                 *time.Time          `json:"updated_at"`
-	Upvotes                   int                 `json:"upvotes"`
-	Downvotes                 int                 `json:"downvotes"`
-	Author                    *BasicUser          `json:"author"`
-	Assignee                  *BasicUser          `json:"assignee"`
-	Assignees                 []*BasicUser        `json:"assignees"`
-	Reviewers                 []*BasicUser        `json:"reviewers"`
-	SourceProjectID           int                 `json:"source_project_id"`
-	TargetProjectID           int                 `json:"target_project_id"`
-	Labels                    Labels              `json:"labels"`
-	LabelDetails              []*LabelDetails     `json:"label_details"`
-	Description               string              `json:"description"`
-	Draft                     bool                `json:"draft"`
-	WorkInProgress            bool                `json:"work_in_progress"`
-	Milestone                 *Milestone          `json:"milestone"`
-	MergeWhenPipelineSucceeds bool                `json:"merge_when_pipeline_succeeds"`
-	DetailedMergeStatus       string              `json:"detailed_merge_status"`
-	MergeError                string              `json:"merge_error"`
-	MergedBy                  *BasicUser          `json:"merged_by"`
-	MergedAt                  *time.Time          `json:"merged_at"`
-	ClosedBy                  *BasicUser          `json:"closed_by"`
-	ClosedAt                  *time.Time          `json:"closed_at"`
-	Subscribed                bool                `json:"subscribed"`
-	SHA                       string              `json:"sha"`
-	MergeCommitSHA            string              `json:"merge_commit_sha"`
-	SquashCommitSHA           string              `json:"squash_commit_sha"`
-	UserNotesCount            int                 `json:"user_notes_count"`
-	ChangesCount              string              `json:"changes_count"`
-	ShouldRemoveSourceBranch  bool                `json:"should_remove_source_branch"`
-	ForceRemoveSourceBranch   bool                `json:"force_remove_source_branch"`
-	AllowCollaboration        bool                `json:"allow_collaboration"`
-	WebURL                    string              `json:"web_url"`
-	References                *IssueReferences    `json:"references"`
-	DiscussionLocked          bool                `json:"discussion_locked"`
-	Changes                   []*MergeRequestDiff `json:"changes"`
+	ID                        int                      `json:"id"`
+	IID                       int                      `json:"iid"`
+	TargetBranch              string                   `json:"target_branch"`
+	SourceBranch              string                   `json:"source_branch"`
+	ProjectID                 int                      `json:"project_id"`
+	Title                     string                   `json:"title"`
+	State                     string                   `json:"state"`
+	CreatedAt                 *time.Time               `json:"created_at"`
+	UpdatedAt                 *time.Time               `json:"updated_at"`
+	Upvotes                   int                      `json:"upvotes"`
+	Downvotes                 int                      `json:"downvotes"`
+	Author                    *BasicUser               `json:"author"`
+	Assignee                  *BasicUser               `json:"assignee"`
+	Assignees                 []*BasicUser             `json:"assignees"`
+	Reviewers                 []*BasicUser             `json:"reviewers"`
+	SourceProjectID           int                      `json:"source_project_id"`
+	TargetProjectID           int                      `json:"target_project_id"`
+	Labels                    Labels                   `json:"labels"`
+	LabelDetails              []*LabelDetails          `json:"label_details"`
+	Description               string                   `json:"description"`
+	Draft                     bool                     `json:"draft"`
+	WorkInProgress            bool                     `json:"work_in_progress"`
+	Milestone                 *Milestone               `json:"milestone"`
+	MergeWhenPipelineSucceeds bool                     `json:"merge_when_pipeline_succeeds"`
+	DetailedMergeStatus       DetailedMergeStatusValue `json:"detailed_merge_status"`
+	MergeError                string                   `json:"merge_error"`
+	MergedBy                  *BasicUser               `json:"merged_by"`
+	MergedAt                  *time.Time               `json:"merged_at"`
+	ClosedBy                  *BasicUser               `json:"closed_by"`
+	ClosedAt                  *time.Time               `json:"closed_at"`
+	Subscribed                bool                     `json:"subscribed"`
+	SHA                       string                   `json:"sha"`
+	MergeCommitSHA            string                   `json:"merge_commit_sha"`
+	SquashCommitSHA           string                   `json:"squash_commit_sha"`
+	UserNotesCount            int                      `json:"user_notes_count"`
+	ChangesCount              string                   `json:"changes_count"`
+	ShouldRemoveSourceBranch  bool                     `json:"should_remove_source_branch"`
+	ForceRemoveSourceBranch   bool                     `json:"force_remove_source_branch"`
+	AllowCollaboration        bool                     `json:"allow_collaboration"`
+	WebURL                    string                   `json:"web_url"`
+	References                *IssueReferences         `json:"references"`
+	DiscussionLocked          bool                     `json:"discussion_locked"`
+	Changes                   []*MergeRequestDiff      `json:"changes"`
 	User                      struct {
 		CanMerge bool `json:"can_merge"`
 	} `json:"user"`
@@ -194,6 +206,8 @@ type ListMergeRequestsOptions struct {
 	View                   *string           `url:"view,omitempty" json:"view,omitempty"`
 	Labels                 *LabelOptions     `url:"labels,comma,omitempty" json:"labels,omitempty"`
 	NotLabels              *LabelOptions     `url:"not[labels],comma,omitempty" json:"not[labels],omitempty"`
+	Not                    *NotFilter        `url:"not,omitempty" json:"not,omitempty"`
+	NotMilestone           *string           `url:"not[milestone],omitempty" json:"not[milestone],omitempty"`
 	WithLabelsDetails      *bool             `url:"with_labels_details,omitempty" json:"with_labels_details,omitempty"`
 	WithMergeStatusRecheck *bool             `url:"with_merge_status_recheck,omitempty" json:"with_merge_status_recheck,omitempty"`
 	CreatedAfter           *time.Time        `url:"created_after,omitempty" json:"created_after,omitempty"`
@@ -203,8 +217,12 @@ type ListMergeRequestsOptions struct {
 	Scope                  *string           `url:"scope,omitempty" json:"scope,omitempty"`
 	AuthorID               *int              `url:"author_id,omitempty" json:"author_id,omitempty"`
 	AuthorUsername         *string           `url:"author_username,omitempty" json:"author_username,omitempty"`
+	AuthorUsernames        *[]string         `url:"author_username[],omitempty" json:"author_username[],omitempty"`
 	NotAuthorUsername      *string           `url:"not[author_username],omitempty" json:"not[author_username],omitempty"`
+	NotAuthorID            *[]int            `url:"not[author_id],omitempty" json:"not[author_id],omitempty"`
 	AssigneeID             *AssigneeIDValue  `url:"assignee_id,omitempty" json:"assignee_id,omitempty"`
+	NotAssigneeID          *[]int            `url:"not[assignee_id],omitempty" json:"not[assignee_id],omitempty"`
+	AssigneeUsernames      *[]string         `url:"assignee_username[],omitempty" json:"assignee_username[],omitempty"`
 	ApproverIDs            *ApproverIDsValue `url:"approver_ids,omitempty" json:"approver_ids,omitempty"`
 	ApprovedByIDs          *ApproverIDsValue `url:"approved_by_ids,omitempty" json:"approved_by_ids,omitempty"`
 	ReviewerID             *ReviewerIDValue  `url:"reviewer_id,omitempty" json:"reviewer_id,omitempty"`
@@ -218,6 +236,19 @@ type ListMergeRequestsOptions struct {
 	WIP                    *string           `url:"wip,omitempty" json:"wip,omitempty"`
 }
 
+// validate checks the user filters of the options.
+func (opt *ListMergeRequestsOptions) validate() error {
+	if opt == nil {
+		return nil
+	}
+	return validateUserFilters(
+		opt.AssigneeID != nil,
+		opt.AssigneeUsernames != nil,
+		opt.AuthorID != nil,
+		opt.AuthorUsername != nil || opt.AuthorUsernames != nil,
+	)
+}
+
 // ListMergeRequests gets all merge requests. The state parameter can be used
 // to get only merge requests with a given state (opened, closed, or merged)
 // or all of them (all). The pagination parameters page and per_page can be
@@ -226,6 +257,10 @@ type ListMergeRequestsOptions struct {
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/merge_requests.html#list-merge-requests
 func (s *MergeRequestsService) ListMergeRequests(opt *ListMergeRequestsOptions, options ...RequestOptionFunc) ([]*MergeRequest, *Response, error) {
+	if err := opt.validate(); err != nil {
+		return nil, nil, err
+	}
+
 	req, err := s.client.NewRequest(http.MethodGet, "merge_requests", opt, options)
 	if err != nil {
 		return nil, nil, err
@@ -255,6 +290,8 @@ type ListProjectMergeRequestsOptions struct {
 	View                   *string           `url:"view,omitempty" json:"view,omitempty"`
 	Labels                 *LabelOptions     `url:"labels,comma,omitempty" json:"labels,omitempty"`
 	NotLabels              *LabelOptions     `url:"not[labels],comma,omitempty" json:"not[labels],omitempty"`
+	Not                    *NotFilter        `url:"not,omitempty" json:"not,omitempty"`
+	NotMilestone           *string           `url:"not[milestone],omitempty" json:"not[milestone],omitempty"`
 	WithLabelsDetails      *bool             `url:"with_labels_details,omitempty" json:"with_labels_details,omitempty"`
 	WithMergeStatusRecheck *bool             `url:"with_merge_status_recheck,omitempty" json:"with_merge_status_recheck,omitempty"`
 	CreatedAfter           *time.Time        `url:"created_after,omitempty" json:"created_after,omitempty"`
@@ -264,8 +301,12 @@ type ListProjectMergeRequestsOptions struct {
 	Scope                  *string           `url:"scope,omitempty" json:"scope,omitempty"`
 	AuthorID               *int              `url:"author_id,omitempty" json:"author_id,omitempty"`
 	AuthorUsername         *string           `url:"author_username,omitempty" json:"author_username,omitempty"`
+	AuthorUsernames        *[]string         `url:"author_username[],omitempty" json:"author_username[],omitempty"`
 	NotAuthorUsername      *string           `url:"not[author_username],omitempty" json:"not[author_username],omitempty"`
+	NotAuthorID            *[]int            `url:"not[author_id],omitempty" json:"not[author_id],omitempty"`
 	AssigneeID             *AssigneeIDValue  `url:"assignee_id,omitempty" json:"assignee_id,omitempty"`
+	NotAssigneeID          *[]int            `url:"not[assignee_id],omitempty" json:"not[assignee_id],omitempty"`
+	AssigneeUsernames      *[]string         `url:"assignee_username[],omitempty" json:"assignee_username[],omitempty"`
 	ApproverIDs            *ApproverIDsValue `url:"approver_ids,omitempty" json:"approver_ids,omitempty"`
 	ApprovedByIDs          *ApproverIDsValue `url:"approved_by_ids,omitempty" json:"approved_by_ids,omitempty"`
 	ReviewerID             *ReviewerIDValue  `url:"reviewer_id,omitempty" json:"reviewer_id,omitempty"`
@@ -278,6 +319,19 @@ type ListProjectMergeRequestsOptions struct {
 	WIP                    *string           `url:"wip,omitempty" json:"wip,omitempty"`
 }
 
+// validate checks the user filters of the options.
+func (opt *ListProjectMergeRequestsOptions) validate() error {
+	if opt == nil {
+		return nil
+	}
+	return validateUserFilters(
+		opt.AssigneeID != nil,
+		opt.AssigneeUsernames != nil,
+		opt.AuthorID != nil,
+		opt.AuthorUsername != nil || opt.AuthorUsernames != nil,
+	)
+}
+
 // ListProjectMergeRequests gets all merge requests for this project.
 //
 // GitLab API docs:
@@ -287,6 +341,9 @@ func (s *MergeRequestsService) ListProjectMergeRequests(pid interface{}, opt *Li
 	if err != nil {
 		return nil, nil, err
 	}
+	if err := opt.validate(); err != nil {
+		return nil, nil, err
+	}
 	u := fmt.Sprintf("projects/%s/merge_requests", PathEscape(project))
 
 	req, err := s.client.NewRequest(http.MethodGet, u, opt, options)
@@ -317,6 +374,8 @@ type ListGroupMergeRequestsOptions struct {
 	View                   *string           `url:"view,omitempty" json:"view,omitempty"`
 	Labels                 *LabelOptions     `url:"labels,comma,omitempty" json:"labels,omitempty"`
 	NotLabels              *LabelOptions     `url:"not[labels],comma,omitempty" json:"not[labels],omitempty"`
+	Not                    *NotFilter        `url:"not,omitempty" json:"not,omitempty"`
+	NotMilestone           *string           `url:"not[milestone],omitempty" json:"not[milestone],omitempty"`
 	WithLabelsDetails      *bool             `url:"with_labels_details,omitempty" json:"with_labels_details,omitempty"`
 	WithMergeStatusRecheck *bool             `url:"with_merge_status_recheck,omitempty" json:"with_merge_status_recheck,omitempty"`
 	CreatedAfter           *time.Time        `url:"created_after,omitempty" json:"created_after,omitempty"`
@@ -326,8 +385,12 @@ type ListGroupMergeRequestsOptions struct {
 	Scope                  *string           `url:"scope,omitempty" json:"scope,omitempty"`
 	AuthorID               *int              `url:"author_id,omitempty" json:"author_id,omitempty"`
 	AuthorUsername         *string           `url:"author_username,omitempty" json:"author_username,omitempty"`
+	AuthorUsernames        *[]string         `url:"author_username[],omitempty" json:"author_username[],omitempty"`
 	NotAuthorUsername      *string           `url:"not[author_username],omitempty" json:"not[author_username],omitempty"`
+	NotAuthorID            *[]int            `url:"not[author_id],omitempty" json:"not[author_id],omitempty"`
 	AssigneeID             *AssigneeIDValue  `url:"assignee_id,omitempty" json:"assignee_id,omitempty"`
+	NotAssigneeID          *[]int            `url:"not[assignee_id],omitempty" json:"not[assignee_id],omitempty"`
+	AssigneeUsernames      *[]string         `url:"assignee_username[],omitempty" json:"assignee_username[],omitempty"`
 	ApproverIDs            *ApproverIDsValue `url:"approver_ids,omitempty" json:"approver_ids,omitempty"`
 	ApprovedByIDs          *ApproverIDsValue `url:"approved_by_ids,omitempty" json:"approved_by_ids,omitempty"`
 	ReviewerID             *ReviewerIDValue  `url:"reviewer_id,omitempty" json:"reviewer_id,omitempty"`
@@ -341,6 +404,19 @@ type ListGroupMergeRequestsOptions struct {
 	WIP                    *string           `url:"wip,omitempty" json:"wip,omitempty"`
 }
 
+// validate checks the user filters of the options.
+func (opt *ListGroupMergeRequestsOptions) validate() error {
+	if opt == nil {
+		return nil
+	}
+	return validateUserFilters(
+		opt.AssigneeID != nil,
+		opt.AssigneeUsernames != nil,
+		opt.AuthorID != nil,
+		opt.AuthorUsername != nil || opt.AuthorUsernames != nil,
+	)
+}
+
 // ListGroupMergeRequests gets all merge requests for this group.
 //
 // GitLab API docs:
@@ -350,6 +426,9 @@ func (s *MergeRequestsService) ListGroupMergeRequests(gid interface{}, opt *List
 	if err != nil {
 		return nil, nil, err
 	}
+	if err := opt.validate(); err != nil {
+		return nil, nil, err
+	}
 	u := fmt.Sprintf("groups/%s/merge_requests", PathEscape(group))
 
 	req, err := s.client.NewRequest(http.MethodGet, u, opt, options)
@@ -470,7 +549,8 @@ type GetMergeRequestChangesOptions struct {
 }
 
 // GetMergeRequestChanges shows information about the merge request including
-// its files and changes.
+// its files and changes. The endpoint is not paginated and returns all changes
+// at once, which can time out on large merge requests.
 //
 // Deprecated: This endpoint has been replaced by
 // MergeRequestsService.ListMergeRequestDiffs()
@@ -508,7 +588,8 @@ type ListMergeRequestDiffsOptions struct {
 	Unidiff *bool `url:"unidiff,omitempty" json:"unidiff,omitempty"`
 }
 
-// ListMergeRequestDiffs List diffs of the files changed in a merge request
+// ListMergeRequestDiffs List diffs of the files changed in a merge request.
+// Unlike GetMergeRequestChanges, the results are paginated.
 //
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/merge_requests.html#list-merge-request-diffs
@@ -619,6 +700,8 @@ func (s *MergeRequestsService) ListMergeRequestPipelines(pid interface{}, mergeR
 }
 
 // CreateMergeRequestPipeline creates a new pipeline for a merge request.
+// GitLab does not accept variables for merge request pipelines, use
+// CreateMergeRequestPipelineWithVariables to pass them.
 //
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/merge_requests.html#create-merge-request-pipeline
@@ -643,6 +726,44 @@ func (s *MergeRequestsService) CreateMergeRequestPipeline(pid interface{}, merge
 	return p, resp, nil
 }
 
+// CreateMergeRequestPipelineWithVariables creates a new pipeline with the
+// given variables for the source branch of a merge request. As GitLab does not
+// accept variables for merge request pipelines, this creates a branch pipeline
+// in the source project of the merge request instead, so jobs limited to
+// merge request pipelines do not run. The returned error matches
+// ErrPipelineVariablesNotPermitted when GitLab refuses the variables.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/pipelines.html#create-a-new-pipeline
+func (s *MergeRequestsService) CreateMergeRequestPipelineWithVariables(pid interface{}, mergeRequest int, variables []*PipelineVariable, options ...RequestOptionFunc) (*Pipeline, *Response, error) {
+	mr, resp, err := s.GetMergeRequest(pid, mergeRequest, nil, options...)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	vars := make([]*PipelineVariableOptions, 0, len(variables))
+	for _, v := range variables {
+		vo := &PipelineVariableOptions{Key: Ptr(v.Key), Value: Ptr(v.Value)}
+		if v.VariableType != "" {
+			vo.VariableType = Ptr(v.VariableType)
+		}
+		vars = append(vars, vo)
+	}
+
+	opt := &CreatePipelineOptions{Ref: Ptr(mr.SourceBranch), Variables: &vars}
+	p, resp, err := s.client.Pipelines.CreatePipeline(mr.SourceProjectID, opt, options...)
+	if err != nil {
+		var errResp *ErrorResponse
+		if resp != nil && resp.StatusCode == http.StatusBadRequest &&
+			errors.As(err, &errResp) && strings.Contains(strings.ToLower(errResp.Message), "variables") {
+			return nil, resp, &sentinelError{sentinel: ErrPipelineVariablesNotPermitted, err: err}
+		}
+		return nil, resp, err
+	}
+
+	return p, resp, nil
+}
+
 // GetIssuesClosedOnMergeOptions represents the available GetIssuesClosedOnMerge()
 // options.
 //
@@ -723,6 +844,38 @@ func (s *MergeRequestsService) CreateMergeRequest(pid interface{}, opt *CreateMe
 	return m, resp, nil
 }
 
+// CreateMergeRequestFromTemplate creates a new merge request using the merge
+// request description template with the given name, read from
+// .gitlab/merge_request_templates/<name>.md on the default branch of the
+// project. The template is used as description when opt.Description is empty.
+// When the project has no such template, an error wrapping ErrNotFound is
+// returned and no merge request is created. The given options are not
+// modified.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/user/project/description_templates.html
+func (s *MergeRequestsService) CreateMergeRequestFromTemplate(pid interface{}, opt *CreateMergeRequestOptions, templateName string, options ...RequestOptionFunc) (*MergeRequest, *Response, error) {
+	o := new(CreateMergeRequestOptions)
+	if opt != nil {
+		*o = *opt
+	}
+
+	fileName := fmt.Sprintf(".gitlab/merge_request_templates/%s.md", templateName)
+	template, resp, err := s.client.RepositoryFiles.GetRawFile(pid, fileName, nil, options...)
+	if err != nil {
+		if errors.Is(err, ErrNotFound) {
+			err = fmt.Errorf("merge request template %q: %w", templateName, err)
+		}
+		return nil, resp, err
+	}
+
+	if o.Description == nil || *o.Description == "" {
+		o.Description = Ptr(string(template))
+	}
+
+	return s.CreateMergeRequest(pid, o, options...)
+}
+
 // UpdateMergeRequestOptions represents the available UpdateMergeRequest()
 // options.
 //
@@ -771,6 +924,202 @@ func (s *MergeRequestsService) UpdateMergeRequest(pid interface{}, mergeRequest
 	return m, resp, nil
 }
 
+// ReassignMergeRequestsOptions represents the available
+// ReassignMergeRequests() options. When both roles are left unset, the user is
+// replaced as assignee as well as reviewer.
+type ReassignMergeRequestsOptions struct {
+	Assignees *bool
+	Reviewers *bool
+}
+
+// ReassignMergeRequestsResult represents the outcome of ReassignMergeRequests.
+// Reassigned holds the updated merge requests, while Failed holds the error
+// for every merge request IID that could not be updated.
+type ReassignMergeRequestsResult struct {
+	Reassigned []*MergeRequest
+	Failed     map[int]error
+}
+
+// ReassignMergeRequests finds the open merge requests of a project that are
+// assigned to, or reviewed by, the given user and hands them over to another
+// user. A failure to update one merge request does not abort the others; it
+// is recorded in the Failed map of the result instead. When ctx is done while
+// updating, the result so far is returned together with the error.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/merge_requests.html#update-mr
+func (s *MergeRequestsService) ReassignMergeRequests(ctx context.Context, pid interface{}, fromUserID, toUserID int, opt *ReassignMergeRequestsOptions, options ...RequestOptionFunc) (*ReassignMergeRequestsResult, *Response, error) {
+	options = append([]RequestOptionFunc{WithContext(ctx)}, options...)
+
+	assignees, reviewers := true, true
+	if opt != nil && (opt.Assignees != nil || opt.Reviewers != nil) {
+		assignees = opt.Assignees != nil && *opt.Assignees
+		reviewers = opt.Reviewers != nil && *opt.Reviewers
+	}
+
+	var mrs []*MergeRequest
+	var resp *Response
+	seen := make(map[int]bool)
+
+	list := func(lopt *ListProjectMergeRequestsOptions) error {
+		lopt.ListOptions = ListOptions{PerPage: 100}
+		lopt.State = Ptr("opened")
+		for {
+			ms, r, err := s.ListProjectMergeRequests(pid, lopt, options...)
+			resp = r
+			if err != nil {
+				return err
+			}
+			for _, m := range ms {
+				if !seen[m.IID] {
+					seen[m.IID] = true
+					mrs = append(mrs, m)
+				}
+			}
+			if r.NextPage == 0 {
+				return nil
+			}
+			lopt.Page = r.NextPage
+		}
+	}
+
+	if assignees {
+		if err := list(&ListProjectMergeRequestsOptions{AssigneeID: AssigneeID(fromUserID)}); err != nil {
+			return nil, resp, err
+		}
+	}
+	if reviewers {
+		if err := list(&ListProjectMergeRequestsOptions{ReviewerID: ReviewerID(fromUserID)}); err != nil {
+			return nil, resp, err
+		}
+	}
+
+	result := &ReassignMergeRequestsResult{Failed: make(map[int]error)}
+	for _, m := range mrs {
+		if err := ctx.Err(); err != nil {
+			return result, resp, err
+		}
+
+		uopt := &UpdateMergeRequestOptions{}
+		if assignees {
+			if ids, ok := replaceUserID(m.Assignees, fromUserID, toUserID); ok {
+				uopt.AssigneeIDs = &ids
+			}
+		}
+		if reviewers {
+			if ids, ok := replaceUserID(m.Reviewers, fromUserID, toUserID); ok {
+				uopt.ReviewerIDs = &ids
+			}
+		}
+		if uopt.AssigneeIDs == nil && uopt.ReviewerIDs == nil {
+			continue
+		}
+
+		updated, _, err := s.UpdateMergeRequest(pid, m.IID, uopt, options...)
+		if err != nil {
+			result.Failed[m.IID] = err
+			continue
+		}
+		result.Reassigned = append(result.Reassigned, updated)
+	}
+
+	return result, resp, nil
+}
+
+// replaceUserID returns the IDs of the given users with from replaced by to,
+// and reports whether from was found at all.
+func replaceUserID(users []*BasicUser, from, to int) ([]int, bool) {
+	var ids []int
+	found, hasTo := false, false
+	for _, u := range users {
+		switch u.ID {
+		case from:
+			found = true
+		case to:
+			hasTo = true
+			ids = append(ids, u.ID)
+		default:
+			ids = append(ids, u.ID)
+		}
+	}
+	if found && !hasTo {
+		ids = append(ids, to)
+	}
+	return ids, found
+}
+
+// AddReviewers adds the given users to the reviewers of a merge request,
+// keeping the reviewers that are already set. The current reviewers are
+// fetched first, so changes made between the fetch and the update can still
+// be overwritten. When all users already are reviewers, the merge request is
+// returned without updating it.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/merge_requests.html#update-mr
+func (s *MergeRequestsService) AddReviewers(pid interface{}, mergeRequest int, userIDs []int, options ...RequestOptionFunc) (*MergeRequest, *Response, error) {
+	m, resp, err := s.GetMergeRequest(pid, mergeRequest, nil, options...)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	ids := make([]int, 0, len(m.Reviewers)+len(userIDs))
+	seen := make(map[int]bool)
+	for _, u := range m.Reviewers {
+		if !seen[u.ID] {
+			seen[u.ID] = true
+			ids = append(ids, u.ID)
+		}
+	}
+	changed := false
+	for _, id := range userIDs {
+		if !seen[id] {
+			seen[id] = true
+			ids = append(ids, id)
+			changed = true
+		}
+	}
+	if !changed {
+		return m, resp, nil
+	}
+
+	return s.UpdateMergeRequest(pid, mergeRequest, &UpdateMergeRequestOptions{ReviewerIDs: &ids}, options...)
+}
+
+// RemoveReviewers removes the given users from the reviewers of a merge
+// request, keeping the other reviewers. The current reviewers are fetched
+// first, so changes made between the fetch and the update can still be
+// overwritten. When none of the users is a reviewer, the merge request is
+// returned without updating it.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/merge_requests.html#update-mr
+func (s *MergeRequestsService) RemoveReviewers(pid interface{}, mergeRequest int, userIDs []int, options ...RequestOptionFunc) (*MergeRequest, *Response, error) {
+	m, resp, err := s.GetMergeRequest(pid, mergeRequest, nil, options...)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	remove := make(map[int]bool, len(userIDs))
+	for _, id := range userIDs {
+		remove[id] = true
+	}
+
+	ids := make([]int, 0, len(m.Reviewers))
+	changed := false
+	for _, u := range m.Reviewers {
+		if remove[u.ID] {
+			changed = true
+			continue
+		}
+		ids = append(ids, u.ID)
+	}
+	if !changed {
+		return m, resp, nil
+	}
+
+	return s.UpdateMergeRequest(pid, mergeRequest, &UpdateMergeRequestOptions{ReviewerIDs: &ids}, options...)
+}
+
 // DeleteMergeRequest deletes a merge request.
 //
 // GitLab API docs:
@@ -801,14 +1150,91 @@ type AcceptMergeRequestOptions struct {
 	Squash                    *bool   `url:"squash,omitempty" json:"squash,omitempty"`
 	ShouldRemoveSourceBranch  *bool   `url:"should_remove_source_branch,omitempty" json:"should_remove_source_branch,omitempty"`
 	MergeWhenPipelineSucceeds *bool   `url:"merge_when_pipeline_succeeds,omitempty" json:"merge_when_pipeline_succeeds,omitempty"`
+	AutoMerge                 *bool   `url:"auto_merge,omitempty" json:"auto_merge,omitempty"`
+	AutoMergeStrategy         *string `url:"auto_merge_strategy,omitempty" json:"auto_merge_strategy,omitempty"`
 	SHA                       *string `url:"sha,omitempty" json:"sha,omitempty"`
 }
 
+// autoMergeRequested reports whether the options ask GitLab to merge the merge
+// request automatically once it is ready, instead of right away.
+func (opt *AcceptMergeRequestOptions) autoMergeRequested() bool {
+	if opt == nil {
+		return false
+	}
+	return (opt.MergeWhenPipelineSucceeds != nil && *opt.MergeWhenPipelineSucceeds) ||
+		(opt.AutoMerge != nil && *opt.AutoMerge) ||
+		(opt.AutoMergeStrategy != nil && *opt.AutoMergeStrategy != "")
+}
+
+// autoMergeUnavailable reports whether a refused merge failed because GitLab
+// could not set auto-merge, as opposed to an unrelated reason like a SHA
+// mismatch. This is the case when the merge request has no pipeline while
+// pipelines must succeed, or when GitLab says so in its error message.
+func autoMergeUnavailable(errResp *ErrorResponse, status DetailedMergeStatusValue) bool {
+	if errResp.Response.StatusCode == http.StatusConflict {
+		return false
+	}
+	if status == DetailedMergeStatusCIMustPass {
+		return true
+	}
+	msg := strings.ToLower(errResp.Message)
+	return strings.Contains(msg, "pipeline") ||
+		strings.Contains(msg, "auto merge") ||
+		strings.Contains(msg, "auto-merge")
+}
+
+// ErrAutoMergeUnavailable is matched by the *MergeNotAllowedError returned
+// when auto-merge was requested but GitLab could not set it because the merge
+// request has no pipeline to wait for. GitLab then tries to merge right away,
+// which fails when the merge request is not mergeable yet.
+var ErrAutoMergeUnavailable = errors.New("auto-merge is not available for the merge request")
+
+// MergeNotAllowedError is returned by AcceptMergeRequest when GitLab refuses
+// to merge a merge request, for example because its pipeline has not
+// succeeded yet. DetailedMergeStatus holds the detailed merge status of the
+// merge request at the time of the failure, so callers can decide to retry.
+// AutoMergeRequested is true when the merge was requested with
+// MergeWhenPipelineSucceeds, AutoMerge or AutoMergeStrategy.
+// AutoMergeUnavailable is true when auto-merge was requested but could not be
+// set, in which case the error also matches ErrAutoMergeUnavailable.
+type MergeNotAllowedError struct {
+	DetailedMergeStatus  DetailedMergeStatusValue
+	AutoMergeRequested   bool
+	AutoMergeUnavailable bool
+	Err                  *ErrorResponse
+}
+
+func (e *MergeNotAllowedError) Error() string {
+	msg := e.Err.Error()
+	if e.DetailedMergeStatus != "" {
+		msg = fmt.Sprintf("%s (detailed merge status: %s)", msg, e.DetailedMergeStatus)
+	}
+	if e.AutoMergeUnavailable {
+		msg = fmt.Sprintf("%v: %s", ErrAutoMergeUnavailable, msg)
+	}
+	return msg
+}
+
+func (e *MergeNotAllowedError) Is(target error) bool {
+	return e.AutoMergeUnavailable && target == ErrAutoMergeUnavailable
+}
+
+func (e *MergeNotAllowedError) Unwrap() error {
+	return e.Err
+}
+
 // AcceptMergeRequest merges changes submitted with MR using this API. If merge
 // success you get 200 OK. If it has some conflicts and can not be merged - you
 // get 405 and error message 'Branch cannot be merged'. If merge request is
 // already merged or closed - you get 405 and error message 'Method Not Allowed'
 //
+// When GitLab refuses the merge (405, 406, 409 or 422), a
+// *MergeNotAllowedError is returned carrying the detailed merge status of the
+// merge request. If the error response does not include that status, it is
+// looked up with an additional GetMergeRequest request. When auto-merge was
+// requested but could not be set because there is no pipeline, the error also
+// matches ErrAutoMergeUnavailable.
+//
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/merge_requests.html#merge-a-merge-request
 func (s *MergeRequestsService) AcceptMergeRequest(pid interface{}, mergeRequest int, opt *AcceptMergeRequestOptions, options ...RequestOptionFunc) (*MergeRequest, *Response, error) {
@@ -826,6 +1252,27 @@ func (s *MergeRequestsService) AcceptMergeRequest(pid interface{}, mergeRequest
 	m := new(MergeRequest)
 	resp, err := s.client.Do(req, m)
 	if err != nil {
+		var errResp *ErrorResponse
+		if errors.As(err, &errResp) {
+			switch errResp.Response.StatusCode {
+			case http.StatusMethodNotAllowed, http.StatusNotAcceptable, http.StatusConflict, http.StatusUnprocessableEntity:
+				merr := &MergeNotAllowedError{
+					AutoMergeRequested: opt.autoMergeRequested(),
+					Err:                errResp,
+				}
+
+				var body struct {
+					DetailedMergeStatus DetailedMergeStatusValue `json:"detailed_merge_status"`
+				}
+				if json.Unmarshal(errResp.Body, &body) == nil && body.DetailedMergeStatus != "" {
+					merr.DetailedMergeStatus = body.DetailedMergeStatus
+				} else if mr, _, err := s.GetMergeRequest(pid, mergeRequest, nil, options...); err == nil {
+					merr.DetailedMergeStatus = mr.DetailedMergeStatus
+				}
+				merr.AutoMergeUnavailable = merr.AutoMergeRequested && autoMergeUnavailable(errResp, merr.DetailedMergeStatus)
+				return nil, resp, merr
+			}
+		}
 		return nil, resp, err
 	}
 
@@ -891,6 +1338,94 @@ func (s *MergeRequestsService) RebaseMergeRequest(pid interface{}, mergeRequest
 	return s.client.Do(req, nil)
 }
 
+// MergeRequestRebaseStatus represents the rebase status of a merge request.
+// MergeError and DivergedCommitsCount are nil when the server omits them,
+// which is the case for older GitLab versions.
+type MergeRequestRebaseStatus struct {
+	RebaseInProgress     bool    `json:"rebase_in_progress"`
+	MergeError           *string `json:"merge_error"`
+	DivergedCommitsCount *int    `json:"diverged_commits_count"`
+}
+
+func (m MergeRequestRebaseStatus) String() string {
+	return Stringify(m)
+}
+
+// GetMergeRequestRebaseStatus gets the rebase status of a merge request. It
+// can be used to poll a rebase started with RebaseMergeRequest() until it
+// has completed.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/merge_requests.html#rebase-a-merge-request
+func (s *MergeRequestsService) GetMergeRequestRebaseStatus(pid interface{}, mergeRequest int, options ...RequestOptionFunc) (*MergeRequestRebaseStatus, *Response, error) {
+	project, err := parseID(pid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("projects/%s/merge_requests/%d", PathEscape(project), mergeRequest)
+
+	opt := &GetMergeRequestsOptions{
+		IncludeDivergedCommitsCount: Ptr(true),
+		IncludeRebaseInProgress:     Ptr(true),
+	}
+
+	req, err := s.client.NewRequest(http.MethodGet, u, opt, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	rs := new(MergeRequestRebaseStatus)
+	resp, err := s.client.Do(req, rs)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return rs, resp, nil
+}
+
+// MergeRequestMergeRef represents the merge ref of a merge request.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/merge_requests.html#merge-to-default-merge-ref-path
+type MergeRequestMergeRef struct {
+	CommitID string `json:"commit_id"`
+}
+
+func (m MergeRequestMergeRef) String() string {
+	return Stringify(m)
+}
+
+// GetMergeRequestMergeRef gets the SHA of the merge ref of a merge request
+// (refs/merge-requests/:iid/merge), which holds the result of merging the
+// source branch into the target branch. The returned error matches
+// ErrMergeRefUnavailable when the merge ref cannot be computed.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/merge_requests.html#merge-to-default-merge-ref-path
+func (s *MergeRequestsService) GetMergeRequestMergeRef(pid interface{}, mergeRequest int, options ...RequestOptionFunc) (*MergeRequestMergeRef, *Response, error) {
+	project, err := parseID(pid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("projects/%s/merge_requests/%d/merge_ref", PathEscape(project), mergeRequest)
+
+	req, err := s.client.NewRequest(http.MethodGet, u, nil, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	ref := new(MergeRequestMergeRef)
+	resp, err := s.client.Do(req, ref)
+	if err != nil {
+		if resp != nil && resp.StatusCode == http.StatusBadRequest {
+			return nil, resp, &sentinelError{sentinel: ErrMergeRefUnavailable, err: err}
+		}
+		return nil, resp, err
+	}
+
+	return ref, resp, nil
+}
+
 // GetMergeRequestDiffVersionsOptions represents the available
 // GetMergeRequestDiffVersions() options.
 //
@@ -1078,3 +1613,126 @@ func (s *MergeRequestsService) ResetSpentTime(pid interface{}, mergeRequest int,
 func (s *MergeRequestsService) GetTimeSpent(pid interface{}, mergeRequest int, options ...RequestOptionFunc) (*TimeStats, *Response, error) {
 	return s.timeStats.getTimeSpent(pid, "merge_requests", mergeRequest, options...)
 }
+
+// ReviewTimelineEntry represents a single entry in the review timeline of a
+// merge request. Exactly one of Note, StateEvent and LabelEvent is set,
+// depending on where the entry originates from.
+type ReviewTimelineEntry struct {
+	Kind       ReviewTimelineKindValue
+	CreatedAt  *time.Time
+	Username   string
+	Body       string
+	Note       *Note
+	StateEvent *StateEvent
+	LabelEvent *LabelEvent
+}
+
+func (e ReviewTimelineEntry) String() string {
+	return Stringify(e)
+}
+
+// GetMergeRequestReviewTimeline combines the notes, the state events and the
+// label events of a merge request into a single chronological timeline.
+// Approvals and review requests are only recorded by GitLab as system notes,
+// so they are recognized by the note body.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/notes.html#list-all-merge-request-notes
+func (s *MergeRequestsService) GetMergeRequestReviewTimeline(pid interface{}, mergeRequest int, options ...RequestOptionFunc) ([]*ReviewTimelineEntry, *Response, error) {
+	var timeline []*ReviewTimelineEntry
+	var resp *Response
+
+	nopt := &ListMergeRequestNotesOptions{ListOptions: ListOptions{PerPage: 100}}
+	for {
+		notes, r, err := s.client.Notes.ListMergeRequestNotes(pid, mergeRequest, nopt, options...)
+		if err != nil {
+			return nil, r, err
+		}
+		for _, n := range notes {
+			timeline = append(timeline, &ReviewTimelineEntry{
+				Kind:      reviewTimelineNoteKind(n),
+				CreatedAt: n.CreatedAt,
+				Username:  n.Author.Username,
+				Body:      n.Body,
+				Note:      n,
+			})
+		}
+		if r.NextPage == 0 {
+			break
+		}
+		nopt.Page = r.NextPage
+	}
+
+	sopt := &ListStateEventsOptions{ListOptions: ListOptions{PerPage: 100}}
+	for {
+		events, r, err := s.client.ResourceStateEvents.ListMergeStateEvents(pid, mergeRequest, sopt, options...)
+		if err != nil {
+			return nil, r, err
+		}
+		for _, e := range events {
+			entry := &ReviewTimelineEntry{
+				Kind:       ReviewTimelineStateChange,
+				CreatedAt:  e.CreatedAt,
+				Body:       string(e.State),
+				StateEvent: e,
+			}
+			if e.User != nil {
+				entry.Username = e.User.Username
+			}
+			timeline = append(timeline, entry)
+		}
+		if r.NextPage == 0 {
+			break
+		}
+		sopt.Page = r.NextPage
+	}
+
+	lopt := &ListLabelEventsOptions{ListOptions: ListOptions{PerPage: 100}}
+	for {
+		events, r, err := s.client.ResourceLabelEvents.ListMergeRequestsLabelEvents(pid, mergeRequest, lopt, options...)
+		if err != nil {
+			return nil, r, err
+		}
+		for _, e := range events {
+			timeline = append(timeline, &ReviewTimelineEntry{
+				Kind:       ReviewTimelineLabelChange,
+				CreatedAt:  e.CreatedAt,
+				Username:   e.User.Username,
+				Body:       fmt.Sprintf("%s label %s", e.Action, e.Label.Name),
+				LabelEvent: e,
+			})
+		}
+		resp = r
+		if r.NextPage == 0 {
+			break
+		}
+		lopt.Page = r.NextPage
+	}
+
+	sort.SliceStable(timeline, func(i, j int) bool {
+		a, b := timeline[i].CreatedAt, timeline[j].CreatedAt
+		if a == nil || b == nil {
+			return a != nil
+		}
+		return a.Before(*b)
+	})
+
+	return timeline, resp, nil
+}
+
+func reviewTimelineNoteKind(n *Note) ReviewTimelineKindValue {
+	if !n.System {
+		return ReviewTimelineComment
+	}
+
+	switch body := strings.ToLower(n.Body); {
+	case strings.HasPrefix(body, "approved this merge request"):
+		return ReviewTimelineApproval
+	case strings.HasPrefix(body, "unapproved this merge request"):
+		return ReviewTimelineUnapproval
+	case strings.HasPrefix(body, "requested review from"):
+		return ReviewTimelineReviewRequested
+	default:
+		return ReviewTimelineSystemNote
+	}
+}
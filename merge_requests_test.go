@@ -17,7 +17,9 @@
 package gitlab
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 	"reflect"
@@ -126,7 +128,7 @@ func TestGetMergeRequest(t *testing.T) {
 		"## What does this MR do?\r\n\r\nThis adds the capability to destroy/hide designs.")
 	require.Equal(t, mergeRequest.WebURL,
 		"https://gitlab.com/gitlab-org/gitlab-ee/merge_requests/14656")
-	require.Equal(t, mergeRequest.DetailedMergeStatus, "mergeable")
+	require.Equal(t, DetailedMergeStatusMergeable, mergeRequest.DetailedMergeStatus)
 	require.Equal(t, mergeRequest.Author, &ajk)
 	require.Equal(t, mergeRequest.Assignee, &tk)
 	require.Equal(t, mergeRequest.Assignees, []*BasicUser{&tk})
@@ -168,7 +170,7 @@ func TestListProjectMergeRequests(t *testing.T) {
 	require.Equal(t, 3, len(mergeRequests))
 
 	validStates := []string{"opened", "closed", "locked", "merged"}
-	detailedMergeStatuses := []string{
+	detailedMergeStatuses := []DetailedMergeStatusValue{
 		"blocked_status",
 		"broken_status",
 		"checking",
@@ -225,7 +227,7 @@ func TestListProjectMergeRequestsAuthorUsername(t *testing.T) {
 	require.Equal(t, 1, len(mergeRequests))
 
 	validStates := []string{"opened", "closed", "locked", "merged"}
-	detailedMergeStatuses := []string{
+	detailedMergeStatuses := []DetailedMergeStatusValue{
 		"blocked_status",
 		"broken_status",
 		"checking",
@@ -282,7 +284,7 @@ func TestListProjectMergeRequestsNotAuthorUsername(t *testing.T) {
 	require.Equal(t, 2, len(mergeRequests))
 
 	validStates := []string{"opened", "closed", "locked", "merged"}
-	detailedMergeStatuses := []string{
+	detailedMergeStatuses := []DetailedMergeStatusValue{
 		"blocked_status",
 		"broken_status",
 		"checking",
@@ -332,6 +334,164 @@ func TestCreateMergeRequestPipeline(t *testing.T) {
 	assert.Equal(t, "pending", pipeline.Status)
 }
 
+func TestCreateMergeRequestFromTemplate(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/1/repository/files/.gitlab/merge_request_templates/Feature.md/raw", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, "## What does this MR do?\n\n- [ ] Tests added\n")
+	})
+	mux.HandleFunc("/api/v4/projects/1/merge_requests", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testBody(t, r, `{"title":"Add feature","description":"## What does this MR do?\n\n- [ ] Tests added\n","source_branch":"feature","target_branch":"main"}`)
+		fmt.Fprint(w, `{"id":1,"iid":3,"title":"Add feature","description":"## What does this MR do?\n\n- [ ] Tests added\n"}`)
+	})
+
+	opt := &CreateMergeRequestOptions{
+		Title:        Ptr("Add feature"),
+		Description:  Ptr(""),
+		SourceBranch: Ptr("feature"),
+		TargetBranch: Ptr("main"),
+	}
+	mr, _, err := client.MergeRequests.CreateMergeRequestFromTemplate(1, opt, "Feature")
+	require.NoError(t, err)
+	assert.Equal(t, 3, mr.IID)
+	assert.Equal(t, "## What does this MR do?\n\n- [ ] Tests added\n", mr.Description)
+	assert.Equal(t, "", *opt.Description)
+}
+
+func TestCreateMergeRequestFromTemplateKeepsDescription(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/1/repository/files/.gitlab/merge_request_templates/Feature.md/raw", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, "## What does this MR do?\n")
+	})
+	mux.HandleFunc("/api/v4/projects/1/merge_requests", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testBody(t, r, `{"title":"Add feature","description":"Custom description","source_branch":"feature","target_branch":"main"}`)
+		fmt.Fprint(w, `{"id":1,"iid":3,"description":"Custom description"}`)
+	})
+
+	mr, _, err := client.MergeRequests.CreateMergeRequestFromTemplate(1, &CreateMergeRequestOptions{
+		Title:        Ptr("Add feature"),
+		Description:  Ptr("Custom description"),
+		SourceBranch: Ptr("feature"),
+		TargetBranch: Ptr("main"),
+	}, "Feature")
+	require.NoError(t, err)
+	assert.Equal(t, "Custom description", mr.Description)
+}
+
+func TestCreateMergeRequestFromMissingTemplate(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/1/repository/files/.gitlab/merge_request_templates/Missing.md/raw", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		w.WriteHeader(http.StatusNotFound)
+	})
+	mux.HandleFunc("/api/v4/projects/1/merge_requests", func(w http.ResponseWriter, r *http.Request) {
+		t.Error("merge request must not be created when the template is missing")
+	})
+
+	mr, resp, err := client.MergeRequests.CreateMergeRequestFromTemplate(1, &CreateMergeRequestOptions{
+		Title:        Ptr("Add feature"),
+		SourceBranch: Ptr("feature"),
+		TargetBranch: Ptr("main"),
+	}, "Missing")
+	require.ErrorIs(t, err, ErrNotFound)
+	assert.Contains(t, err.Error(), `merge request template "Missing"`)
+	assert.Nil(t, mr)
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+}
+
+func TestCreateMergeRequestPipelineWithVariables(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/1/merge_requests/5", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{"id":1,"iid":5,"project_id":1,"source_project_id":7,"source_branch":"feature/x"}`)
+	})
+	mux.HandleFunc("/api/v4/projects/7/pipeline", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testBody(t, r, `{"ref":"feature/x","variables":[{"key":"DEPLOY","value":"true"},{"key":"CONFIG","value":"a=b","variable_type":"file"}]}`)
+		fmt.Fprint(w, `{"id":11,"project_id":7,"ref":"feature/x","status":"created"}`)
+	})
+
+	pipeline, _, err := client.MergeRequests.CreateMergeRequestPipelineWithVariables(1, 5, []*PipelineVariable{
+		{Key: "DEPLOY", Value: "true"},
+		{Key: "CONFIG", Value: "a=b", VariableType: FileVariableType},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 11, pipeline.ID)
+	assert.Equal(t, "feature/x", pipeline.Ref)
+}
+
+func TestCreateMergeRequestPipelineWithVariablesNotPermitted(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/1/merge_requests/5", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{"id":1,"iid":5,"project_id":1,"source_project_id":1,"source_branch":"feature/x"}`)
+	})
+	mux.HandleFunc("/api/v4/projects/1/pipeline", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(w, `{"message":"Insufficient permissions to set pipeline variables"}`)
+	})
+
+	_, resp, err := client.MergeRequests.CreateMergeRequestPipelineWithVariables(1, 5, []*PipelineVariable{
+		{Key: "DEPLOY", Value: "true"},
+	})
+	require.ErrorIs(t, err, ErrPipelineVariablesNotPermitted)
+	require.Equal(t, http.StatusBadRequest, resp.StatusCode)
+
+	var errResp *ErrorResponse
+	require.ErrorAs(t, err, &errResp)
+}
+
+func TestGetMergeRequestRebaseStatus(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/1/merge_requests/5", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testParams(t, r, "include_diverged_commits_count=true&include_rebase_in_progress=true")
+		fmt.Fprint(w, `{"id":1,"iid":5,"rebase_in_progress":true,"merge_error":null,"diverged_commits_count":2}`)
+	})
+
+	status, _, err := client.MergeRequests.GetMergeRequestRebaseStatus(1, 5)
+	if err != nil {
+		t.Fatalf("MergeRequests.GetMergeRequestRebaseStatus returned error: %v", err)
+	}
+
+	want := &MergeRequestRebaseStatus{
+		RebaseInProgress:     true,
+		DivergedCommitsCount: Ptr(2),
+	}
+	if !reflect.DeepEqual(want, status) {
+		t.Errorf("MergeRequests.GetMergeRequestRebaseStatus returned %+v, want %+v", status, want)
+	}
+}
+
+func TestGetMergeRequestRebaseStatusOmittedFields(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/1/merge_requests/5", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{"id":1,"iid":5}`)
+	})
+
+	status, _, err := client.MergeRequests.GetMergeRequestRebaseStatus(1, 5)
+	if err != nil {
+		t.Fatalf("MergeRequests.GetMergeRequestRebaseStatus returned error: %v", err)
+	}
+
+	want := &MergeRequestRebaseStatus{}
+	if !reflect.DeepEqual(want, status) {
+		t.Errorf("MergeRequests.GetMergeRequestRebaseStatus returned %+v, want %+v", status, want)
+	}
+}
+
 func TestGetMergeRequestParticipants(t *testing.T) {
 	mux, client := setup(t)
 
@@ -500,3 +660,372 @@ func TestAssigneeIDMarshalling(t *testing.T) {
 		assert.Equal(t, `{"assignee_id":5}`, string(js))
 	})
 }
+
+func TestAcceptMergeRequest(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/1/merge_requests/5/merge", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPut)
+		testBody(t, r, `{"merge_commit_message":"Merge feature","squash_commit_message":"Add feature","squash":true,"should_remove_source_branch":true}`)
+		fmt.Fprint(w, `{"id":1,"iid":5,"state":"merged","squash":true}`)
+	})
+
+	opt := &AcceptMergeRequestOptions{
+		MergeCommitMessage:       Ptr("Merge feature"),
+		SquashCommitMessage:      Ptr("Add feature"),
+		Squash:                   Ptr(true),
+		ShouldRemoveSourceBranch: Ptr(true),
+	}
+	mr, _, err := client.MergeRequests.AcceptMergeRequest(1, 5, opt)
+	require.NoError(t, err)
+	assert.Equal(t, "merged", mr.State)
+	assert.True(t, mr.Squash)
+}
+
+func TestAcceptMergeRequestNotAllowed(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/1/merge_requests/5/merge", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPut)
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		fmt.Fprint(w, `{"message":"405 Method Not Allowed"}`)
+	})
+	mux.HandleFunc("/api/v4/projects/1/merge_requests/5", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{"id":1,"iid":5,"detailed_merge_status":"ci_still_running"}`)
+	})
+
+	_, resp, err := client.MergeRequests.AcceptMergeRequest(1, 5, nil)
+	require.Error(t, err)
+	assert.Equal(t, http.StatusMethodNotAllowed, resp.StatusCode)
+
+	var merr *MergeNotAllowedError
+	require.ErrorAs(t, err, &merr)
+	assert.Equal(t, DetailedMergeStatusCIStillRunning, merr.DetailedMergeStatus)
+	assert.Equal(t, "{message: 405 Method Not Allowed}", merr.Err.Message)
+	assert.False(t, errors.Is(err, ErrAutoMergeUnavailable))
+}
+
+func TestAcceptMergeRequestConflictWithDetailedMergeStatus(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/1/merge_requests/5/merge", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPut)
+		w.WriteHeader(http.StatusConflict)
+		fmt.Fprint(w, `{"message":"SHA does not match HEAD of source branch","detailed_merge_status":"need_rebase"}`)
+	})
+	mux.HandleFunc("/api/v4/projects/1/merge_requests/5", func(w http.ResponseWriter, r *http.Request) {
+		t.Error("unexpected request to get the merge request")
+	})
+
+	_, _, err := client.MergeRequests.AcceptMergeRequest(1, 5, &AcceptMergeRequestOptions{SHA: Ptr("abc")})
+
+	var merr *MergeNotAllowedError
+	require.ErrorAs(t, err, &merr)
+	assert.Equal(t, DetailedMergeStatusNeedRebase, merr.DetailedMergeStatus)
+}
+
+func TestAcceptMergeRequestAutoMerge(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/1/merge_requests/5/merge", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPut)
+		testBody(t, r, `{"merge_when_pipeline_succeeds":true,"auto_merge":true,"sha":"abc"}`)
+		fmt.Fprint(w, `{"id":1,"iid":5,"state":"opened","merge_when_pipeline_succeeds":true}`)
+	})
+
+	mr, _, err := client.MergeRequests.AcceptMergeRequest(1, 5, &AcceptMergeRequestOptions{
+		MergeWhenPipelineSucceeds: Ptr(true),
+		AutoMerge:                 Ptr(true),
+		SHA:                       Ptr("abc"),
+	})
+	require.NoError(t, err)
+	assert.True(t, mr.MergeWhenPipelineSucceeds)
+}
+
+func TestAcceptMergeRequestAutoMergeUnavailable(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/1/merge_requests/5/merge", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPut)
+		testBody(t, r, `{"auto_merge":true}`)
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		fmt.Fprint(w, `{"message":"405 Method Not Allowed","detailed_merge_status":"ci_must_pass"}`)
+	})
+
+	_, _, err := client.MergeRequests.AcceptMergeRequest(1, 5, &AcceptMergeRequestOptions{AutoMerge: Ptr(true)})
+	require.ErrorIs(t, err, ErrAutoMergeUnavailable)
+
+	var merr *MergeNotAllowedError
+	require.ErrorAs(t, err, &merr)
+	assert.True(t, merr.AutoMergeRequested)
+	assert.True(t, merr.AutoMergeUnavailable)
+	assert.Equal(t, DetailedMergeStatusCIMustPass, merr.DetailedMergeStatus)
+	assert.Contains(t, err.Error(), "auto-merge is not available")
+}
+
+func TestAcceptMergeRequestAutoMergeSHAMismatch(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/1/merge_requests/5/merge", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPut)
+		testBody(t, r, `{"auto_merge":true,"auto_merge_strategy":"merge_when_checks_pass","sha":"abc"}`)
+		w.WriteHeader(http.StatusConflict)
+		fmt.Fprint(w, `{"message":"SHA does not match HEAD of source branch","detailed_merge_status":"ci_must_pass"}`)
+	})
+
+	_, _, err := client.MergeRequests.AcceptMergeRequest(1, 5, &AcceptMergeRequestOptions{
+		AutoMerge:         Ptr(true),
+		AutoMergeStrategy: Ptr("merge_when_checks_pass"),
+		SHA:               Ptr("abc"),
+	})
+
+	var merr *MergeNotAllowedError
+	require.ErrorAs(t, err, &merr)
+	assert.True(t, merr.AutoMergeRequested)
+	assert.False(t, merr.AutoMergeUnavailable)
+	assert.False(t, errors.Is(err, ErrAutoMergeUnavailable))
+}
+
+func TestListProjectMergeRequestsNotFilters(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/1/merge_requests", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testParams(t, r, "author_id=1&not%5Bassignee_id%5D=3&not%5Bauthor_id%5D=2&not%5Blabels%5D=wip%2Cblocked&not%5Bmilestone%5D=v1.0")
+		fmt.Fprint(w, `[{"id":1,"iid":5}]`)
+	})
+
+	mrs, _, err := client.MergeRequests.ListProjectMergeRequests(1, &ListProjectMergeRequestsOptions{
+		AuthorID:      Ptr(1),
+		NotAuthorID:   &[]int{2},
+		NotAssigneeID: &[]int{3},
+		NotLabels:     &LabelOptions{"wip", "blocked"},
+		NotMilestone:  Ptr("v1.0"),
+	})
+	require.NoError(t, err)
+	require.Len(t, mrs, 1)
+	assert.Equal(t, 5, mrs[0].IID)
+}
+
+func TestListMergeRequestsNotFilter(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/merge_requests", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testParams(t, r, "not%5Bauthor_id%5D=2&not%5Bauthor_id%5D=4&not%5Blabels%5D=wip&state=opened")
+		fmt.Fprint(w, `[{"id":1,"iid":5}]`)
+	})
+
+	mrs, _, err := client.MergeRequests.ListMergeRequests(&ListMergeRequestsOptions{
+		State: Ptr("opened"),
+		Not: &NotFilter{
+			Labels:   &LabelOptions{"wip"},
+			AuthorID: &[]int{2, 4},
+		},
+	})
+	require.NoError(t, err)
+	require.Len(t, mrs, 1)
+}
+
+func TestGetMergeRequestMergeRef(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/1/merge_requests/5/merge_ref", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{"commit_id":"854a3a7a17acbcc0bbbea170986df1eb60435f34"}`)
+	})
+	mux.HandleFunc("/api/v4/projects/1/merge_requests/6/merge_ref", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		http.Error(w, `{"message":"Merge request is not mergeable"}`, http.StatusBadRequest)
+	})
+
+	ref, _, err := client.MergeRequests.GetMergeRequestMergeRef(1, 5)
+	require.NoError(t, err)
+	assert.Equal(t, "854a3a7a17acbcc0bbbea170986df1eb60435f34", ref.CommitID)
+
+	ref, resp, err := client.MergeRequests.GetMergeRequestMergeRef(1, 6)
+	assert.Nil(t, ref)
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	assert.ErrorIs(t, err, ErrMergeRefUnavailable)
+}
+
+func TestReassignMergeRequestsReviewer(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/1/merge_requests", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testParams(t, r, "per_page=100&reviewer_id=3&state=opened")
+		fmt.Fprint(w, `[{"id":1,"iid":5,"reviewers":[{"id":2},{"id":3}]}]`)
+	})
+	mux.HandleFunc("/api/v4/projects/1/merge_requests/5", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPut)
+		testBody(t, r, `{"reviewer_ids":[2,4]}`)
+		fmt.Fprint(w, `{"id":1,"iid":5,"reviewers":[{"id":2},{"id":4}]}`)
+	})
+
+	opt := &ReassignMergeRequestsOptions{Reviewers: Ptr(true)}
+	result, _, err := client.MergeRequests.ReassignMergeRequests(context.Background(), 1, 3, 4, opt)
+	require.NoError(t, err)
+	assert.Empty(t, result.Failed)
+	require.Len(t, result.Reassigned, 1)
+	assert.Equal(t, 5, result.Reassigned[0].IID)
+	assert.Equal(t, 4, result.Reassigned[0].Reviewers[1].ID)
+}
+
+func TestReassignMergeRequestsFailure(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/1/merge_requests", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		if r.URL.Query().Get("assignee_id") == "3" {
+			fmt.Fprint(w, `[{"id":1,"iid":5,"assignees":[{"id":3}]}]`)
+			return
+		}
+		fmt.Fprint(w, `[]`)
+	})
+	mux.HandleFunc("/api/v4/projects/1/merge_requests/5", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPut)
+		http.Error(w, `{"message":"403 Forbidden"}`, http.StatusForbidden)
+	})
+
+	result, _, err := client.MergeRequests.ReassignMergeRequests(context.Background(), 1, 3, 4, nil)
+	require.NoError(t, err)
+	assert.Empty(t, result.Reassigned)
+	assert.Error(t, result.Failed[5])
+}
+
+func TestReassignMergeRequestsCanceled(t *testing.T) {
+	mux, client := setup(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	mux.HandleFunc("/api/v4/projects/1/merge_requests", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `[{"id":1,"iid":5,"assignees":[{"id":3}]},{"id":2,"iid":6,"assignees":[{"id":3}]}]`)
+	})
+	mux.HandleFunc("/api/v4/projects/1/merge_requests/5", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPut)
+		fmt.Fprint(w, `{"id":1,"iid":5,"assignees":[{"id":4}]}`)
+		w.(http.Flusher).Flush()
+		cancel()
+	})
+	mux.HandleFunc("/api/v4/projects/1/merge_requests/6", func(w http.ResponseWriter, r *http.Request) {
+		t.Error("unexpected request to update merge request 6")
+	})
+
+	opt := &ReassignMergeRequestsOptions{Assignees: Ptr(true)}
+	result, _, err := client.MergeRequests.ReassignMergeRequests(ctx, 1, 3, 4, opt)
+	require.ErrorIs(t, err, context.Canceled)
+	require.NotNil(t, result)
+	assert.Equal(t, 1, len(result.Reassigned)+len(result.Failed))
+}
+
+func TestAddReviewers(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/1/merge_requests/5", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			fmt.Fprint(w, `{"id":1,"iid":5,"reviewers":[{"id":2},{"id":3}]}`)
+		case http.MethodPut:
+			testBody(t, r, `{"reviewer_ids":[2,3,4]}`)
+			fmt.Fprint(w, `{"id":1,"iid":5,"reviewers":[{"id":2},{"id":3},{"id":4}]}`)
+		default:
+			t.Errorf("unexpected method %s", r.Method)
+		}
+	})
+
+	mr, _, err := client.MergeRequests.AddReviewers(1, 5, []int{3, 4})
+	require.NoError(t, err)
+	require.Len(t, mr.Reviewers, 3)
+	assert.Equal(t, 4, mr.Reviewers[2].ID)
+}
+
+func TestRemoveReviewers(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/1/merge_requests/5", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			fmt.Fprint(w, `{"id":1,"iid":5,"reviewers":[{"id":2},{"id":3}]}`)
+		case http.MethodPut:
+			testBody(t, r, `{"reviewer_ids":[]}`)
+			fmt.Fprint(w, `{"id":1,"iid":5,"reviewers":[]}`)
+		default:
+			t.Errorf("unexpected method %s", r.Method)
+		}
+	})
+
+	mr, _, err := client.MergeRequests.RemoveReviewers(1, 5, []int{2, 3})
+	require.NoError(t, err)
+	assert.Empty(t, mr.Reviewers)
+}
+
+func TestRemoveReviewersUnchanged(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/1/merge_requests/5", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{"id":1,"iid":5,"reviewers":[{"id":2}]}`)
+	})
+
+	mr, _, err := client.MergeRequests.RemoveReviewers(1, 5, []int{7})
+	require.NoError(t, err)
+	require.Len(t, mr.Reviewers, 1)
+}
+
+func TestGetMergeRequestReviewTimeline(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/1/merge_requests/5/notes", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `[
+			{"id":3,"body":"Looks good to me","system":false,"author":{"username":"alice"},"created_at":"2024-05-01T12:00:00Z"},
+			{"id":2,"body":"approved this merge request","system":true,"author":{"username":"bob"},"created_at":"2024-05-01T11:00:00Z"},
+			{"id":1,"body":"requested review from @bob","system":true,"author":{"username":"alice"},"created_at":"2024-05-01T09:00:00Z"}
+		]`)
+	})
+	mux.HandleFunc("/api/v4/projects/1/merge_requests/5/resource_state_events", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `[{"id":10,"state":"merged","user":{"username":"alice"},"created_at":"2024-05-01T13:00:00Z"}]`)
+	})
+	mux.HandleFunc("/api/v4/projects/1/merge_requests/5/resource_label_events", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `[{"id":20,"action":"add","label":{"name":"ready"},"user":{"username":"alice"},"created_at":"2024-05-01T10:00:00Z"}]`)
+	})
+
+	timeline, _, err := client.MergeRequests.GetMergeRequestReviewTimeline(1, 5)
+	require.NoError(t, err)
+
+	var kinds []ReviewTimelineKindValue
+	var users []string
+	for _, e := range timeline {
+		kinds = append(kinds, e.Kind)
+		users = append(users, e.Username)
+	}
+	assert.Equal(t, []ReviewTimelineKindValue{
+		ReviewTimelineReviewRequested,
+		ReviewTimelineLabelChange,
+		ReviewTimelineApproval,
+		ReviewTimelineComment,
+		ReviewTimelineStateChange,
+	}, kinds)
+	assert.Equal(t, []string{"alice", "alice", "bob", "alice", "alice"}, users)
+	assert.Equal(t, "add label ready", timeline[1].Body)
+	assert.Equal(t, 2, timeline[2].Note.ID)
+}
+
+func TestGetMergeRequestReviewTimelineEmpty(t *testing.T) {
+	mux, client := setup(t)
+
+	for _, path := range []string{"notes", "resource_state_events", "resource_label_events"} {
+		mux.HandleFunc("/api/v4/projects/1/merge_requests/5/"+path, func(w http.ResponseWriter, r *http.Request) {
+			fmt.Fprint(w, `[]`)
+		})
+	}
+
+	timeline, _, err := client.MergeRequests.GetMergeRequestReviewTimeline(1, 5)
+	require.NoError(t, err)
+	assert.Empty(t, timeline)
+}
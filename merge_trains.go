@@ -3,6 +3,7 @@ package gitlab
 import (
 	"fmt"
 	"net/http"
+	"net/url"
 	"time"
 )
 
@@ -90,7 +91,7 @@ func (s *MergeTrainsService) ListMergeRequestInMergeTrain(pid interface{}, targe
 	if err != nil {
 		return nil, nil, err
 	}
-	u := fmt.Sprintf("projects/%s/merge_trains/%s", PathEscape(project), targetBranch)
+	u := fmt.Sprintf("projects/%s/merge_trains/%s", PathEscape(project), url.PathEscape(targetBranch))
 
 	req, err := s.client.NewRequest(http.MethodGet, u, opts, options)
 	if err != nil {
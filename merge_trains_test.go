@@ -149,6 +149,26 @@ func TestListMergeRequestInMergeTrain(t *testing.T) {
 	}
 }
 
+func TestListMergeRequestInMergeTrainEscapesTargetBranch(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/597/merge_trains/release/1.0", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testURL(t, r, "/api/v4/projects/597/merge_trains/release%2F1.0")
+		w.Write([]byte(`[{"id":1,"target_branch":"release/1.0"}]`))
+	})
+
+	mergeTrains, _, err := client.MergeTrains.ListMergeRequestInMergeTrain(597, "release/1.0", nil)
+	if err != nil {
+		t.Errorf("MergeTrains.ListMergeRequestInMergeTrain returned error: %v", err)
+	}
+
+	want := []*MergeTrain{{ID: 1, TargetBranch: "release/1.0"}}
+	if !reflect.DeepEqual(want, mergeTrains) {
+		t.Errorf("MergeTrains.ListMergeRequestInMergeTrain returned %+v, want %+v", mergeTrains, want)
+	}
+}
+
 func TestGetMergeRequestOnAMergeTrain(t *testing.T) {
 	mux, client := setup(t)
 
@@ -45,6 +45,11 @@ func (s Metadata) String() string {
 	return Stringify(s)
 }
 
+// SemanticVersion parses the version of the GitLab instance.
+func (s Metadata) SemanticVersion() (SemanticVersion, error) {
+	return ParseVersion(s.Version)
+}
+
 // GetMetadata gets a GitLab server instance meteadata.
 //
 // GitLab API docs: https://docs.gitlab.com/ee/api/metadata.html
@@ -64,4 +64,11 @@ func TestGetMetadata(t *testing.T) {
 	if !reflect.DeepEqual(want, version) {
 		t.Errorf("Metadata.GetMetadata returned %+v, want %+v", version, want)
 	}
+	sv, err := version.SemanticVersion()
+	if err != nil {
+		t.Errorf("Metadata.SemanticVersion returned error: %v", err)
+	}
+	if !sv.AtLeast(15, 6, 0) {
+		t.Errorf("Metadata.SemanticVersion returned %s, want at least 15.6.0", sv)
+	}
 }
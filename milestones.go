@@ -17,11 +17,16 @@
 package gitlab
 
 import (
+	"errors"
 	"fmt"
 	"net/http"
 	"time"
 )
 
+// ErrMilestonePromotionFailed is returned when GitLab rejects the promotion of
+// a project milestone to a group milestone.
+var ErrMilestonePromotionFailed = errors.New("milestone promotion failed")
+
 // MilestonesService handles communication with the milestone related methods
 // of the GitLab API.
 //
@@ -207,6 +212,31 @@ func (s *MilestonesService) DeleteMilestone(pid interface{}, milestone int, opti
 	return s.client.Do(req, nil)
 }
 
+// PromoteProjectMilestone promotes a project milestone to a group milestone
+// of the group the project belongs to. The returned error matches
+// ErrMilestonePromotionFailed when GitLab rejects the promotion.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/milestones.html#promote-project-milestone-to-a-group-milestone
+func (s *MilestonesService) PromoteProjectMilestone(pid interface{}, milestone int, options ...RequestOptionFunc) (*Response, error) {
+	project, err := parseID(pid)
+	if err != nil {
+		return nil, err
+	}
+	u := fmt.Sprintf("projects/%s/milestones/%d/promote", PathEscape(project), milestone)
+
+	req, err := s.client.NewRequest(http.MethodPost, u, nil, options)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil && resp != nil && resp.StatusCode == http.StatusBadRequest {
+		err = &sentinelError{sentinel: ErrMilestonePromotionFailed, err: err}
+	}
+	return resp, err
+}
+
 // GetMilestoneIssuesOptions represents the available GetMilestoneIssues() options.
 //
 // GitLab API docs:
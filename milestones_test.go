@@ -1,6 +1,7 @@
 package gitlab
 
 import (
+	"errors"
 	"fmt"
 	"net/http"
 	"testing"
@@ -234,6 +235,37 @@ func TestMilestonesService_DeleteMilestone(t *testing.T) {
 	require.Equal(t, http.StatusNotFound, resp.StatusCode)
 }
 
+func TestMilestonesService_PromoteProjectMilestone(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/5/milestones/12/promote", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+	})
+	mux.HandleFunc("/api/v4/projects/5/milestones/13/promote", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(w, `{"message":"Promotion failed - Please remove the group milestone with the same title"}`)
+	})
+
+	resp, err := client.Milestones.PromoteProjectMilestone(5, 12)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	resp, err = client.Milestones.PromoteProjectMilestone(5.01, 12)
+	require.EqualError(t, err, "invalid ID type 5.01, the ID must be an int or a string")
+	require.Nil(t, resp)
+
+	resp, err = client.Milestones.PromoteProjectMilestone(5, 13)
+	require.ErrorIs(t, err, ErrMilestonePromotionFailed)
+	require.Contains(t, err.Error(), "same title")
+	require.Equal(t, http.StatusBadRequest, resp.StatusCode)
+
+	resp, err = client.Milestones.PromoteProjectMilestone(3, 12)
+	require.Error(t, err)
+	require.False(t, errors.Is(err, ErrMilestonePromotionFailed))
+	require.Equal(t, http.StatusNotFound, resp.StatusCode)
+}
+
 func TestMilestonesService_GetMilestoneIssues(t *testing.T) {
 	mux, client := setup(t)
 
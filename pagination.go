@@ -0,0 +1,73 @@
+//
+// Copyright 2021, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package gitlab
+
+import (
+	"context"
+)
+
+// StreamChan walks all pages of a list endpoint in a background goroutine and
+// sends every item on the returned data channel. The fn function is called
+// with the options of the page to fetch, starting with page 1 and 100 items
+// per page, and should pass them on to a list method. It should also pass ctx
+// on using WithContext, so pending requests are aborted on cancellation.
+//
+// When fn returns an error or ctx is cancelled, the error is sent on the
+// error channel and no further pages are fetched. Both channels are closed
+// once the goroutine is done, so callers can range over the data channel and
+// then receive from the error channel, which yields nil when all pages were
+// read successfully. The goroutine does not block on the error channel, so
+// callers may stop reading after cancelling ctx.
+func StreamChan[T any](ctx context.Context, fn func(ListOptions) ([]T, *Response, error)) (<-chan T, <-chan error) {
+	items := make(chan T)
+	errs := make(chan error, 1)
+
+	go func() {
+		defer close(items)
+		defer close(errs)
+
+		opt := ListOptions{Page: 1, PerPage: 100}
+		for {
+			if err := ctx.Err(); err != nil {
+				errs <- err
+				return
+			}
+
+			page, resp, err := fn(opt)
+			if err != nil {
+				errs <- err
+				return
+			}
+
+			for _, item := range page {
+				select {
+				case items <- item:
+				case <-ctx.Done():
+					errs <- ctx.Err()
+					return
+				}
+			}
+
+			if resp == nil || resp.NextPage == 0 {
+				return
+			}
+			opt.Page = resp.NextPage
+		}
+	}()
+
+	return items, errs
+}
@@ -0,0 +1,104 @@
+//
+// Copyright 2021, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package gitlab
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestStreamChan(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/1/pipelines", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		switch r.URL.Query().Get("page") {
+		case "1":
+			w.Header().Set("X-Next-Page", "2")
+			fmt.Fprint(w, `[{"id":1},{"id":2}]`)
+		case "2":
+			fmt.Fprint(w, `[{"id":3}]`)
+		default:
+			t.Errorf("unexpected page %q", r.URL.Query().Get("page"))
+		}
+	})
+
+	ctx := context.Background()
+	pipelines, errs := StreamChan(ctx, func(lo ListOptions) ([]*PipelineInfo, *Response, error) {
+		opt := &ListProjectPipelinesOptions{ListOptions: lo}
+		return client.Pipelines.ListProjectPipelines(1, opt, WithContext(ctx))
+	})
+
+	var ids []int
+	for p := range pipelines {
+		ids = append(ids, p.ID)
+	}
+	require.NoError(t, <-errs)
+	require.Equal(t, []int{1, 2, 3}, ids)
+}
+
+func TestStreamChanError(t *testing.T) {
+	errPage := errors.New("page 2 failed")
+
+	items, errs := StreamChan(context.Background(), func(lo ListOptions) ([]int, *Response, error) {
+		if lo.Page == 2 {
+			return nil, nil, errPage
+		}
+		return []int{1, 2}, &Response{NextPage: 2}, nil
+	})
+
+	var got []int
+	for i := range items {
+		got = append(got, i)
+	}
+	require.Equal(t, []int{1, 2}, got)
+	require.ErrorIs(t, <-errs, errPage)
+
+	// The error channel is closed after the error was received.
+	_, ok := <-errs
+	require.False(t, ok)
+}
+
+func TestStreamChanCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	done := make(chan struct{})
+	items, errs := StreamChan(ctx, func(lo ListOptions) ([]int, *Response, error) {
+		return []int{lo.Page, lo.Page}, &Response{NextPage: lo.Page + 1}, nil
+	})
+	go func() {
+		defer close(done)
+		// Stop reading after the first item, the goroutine must not leak.
+		<-items
+		cancel()
+		for range items {
+		}
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("StreamChan did not stop after the context was cancelled")
+	}
+	require.ErrorIs(t, <-errs, context.Canceled)
+}
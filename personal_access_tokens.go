@@ -17,11 +17,16 @@
 package gitlab
 
 import (
+	"errors"
 	"fmt"
 	"net/http"
 	"time"
 )
 
+// ErrAccessTokenNotRotatable is returned when an access token cannot be
+// rotated, which is most likely because it is already expired or revoked.
+var ErrAccessTokenNotRotatable = errors.New("access token cannot be rotated, it is likely expired or revoked")
+
 // PersonalAccessTokensService handles communication with the personal access
 // tokens related methods of the GitLab API.
 //
@@ -157,7 +162,7 @@ func (s *PersonalAccessTokensService) RotatePersonalAccessTokenByID(token int, o
 	pat := new(PersonalAccessToken)
 	resp, err := s.client.Do(req, pat)
 	if err != nil {
-		return nil, resp, err
+		return nil, resp, rotateAccessTokenError(resp, err)
 	}
 
 	return pat, resp, nil
@@ -179,7 +184,7 @@ func (s *PersonalAccessTokensService) RotatePersonalAccessTokenSelf(opt *RotateP
 	pat := new(PersonalAccessToken)
 	resp, err := s.client.Do(req, pat)
 	if err != nil {
-		return nil, resp, err
+		return nil, resp, rotateAccessTokenError(resp, err)
 	}
 
 	return pat, resp, nil
@@ -220,3 +225,12 @@ func (s *PersonalAccessTokensService) RevokePersonalAccessTokenSelf(options ...R
 
 	return s.client.Do(req, nil)
 }
+
+// rotateAccessTokenError maps the 400 Bad Request GitLab returns when rotating
+// a token that is already expired or revoked to ErrAccessTokenNotRotatable.
+func rotateAccessTokenError(resp *Response, err error) error {
+	if resp != nil && resp.StatusCode == http.StatusBadRequest {
+		return &sentinelError{sentinel: ErrAccessTokenNotRotatable, err: err}
+	}
+	return err
+}
@@ -17,6 +17,8 @@
 package gitlab
 
 import (
+	"errors"
+	"fmt"
 	"net/http"
 	"reflect"
 	"testing"
@@ -267,6 +269,31 @@ func TestRotatePersonalAccessToken(t *testing.T) {
 	}
 }
 
+func TestRotatePersonalAccessTokenExpired(t *testing.T) {
+	mux, client := setup(t)
+	mux.HandleFunc("/api/v4/personal_access_tokens/42/rotate", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(w, `{"message":"400 Bad Request"}`)
+	})
+
+	_, resp, err := client.PersonalAccessTokens.RotatePersonalAccessToken(42, nil)
+	if err == nil {
+		t.Fatal("PersonalAccessTokens.RotatePersonalAccessToken expected an error")
+	}
+	if resp.StatusCode != http.StatusBadRequest {
+		t.Errorf("expected status code 400, got %d", resp.StatusCode)
+	}
+	if !errors.Is(err, ErrAccessTokenNotRotatable) {
+		t.Errorf("expected the error to match ErrAccessTokenNotRotatable, got %v", err)
+	}
+
+	var errResp *ErrorResponse
+	if !errors.As(err, &errResp) {
+		t.Errorf("expected the error to wrap an *ErrorResponse, got %T", err)
+	}
+}
+
 func TestRotatePersonalAccessTokenByID(t *testing.T) {
 	mux, client := setup(t)
 	mux.HandleFunc("/api/v4/personal_access_tokens/42/rotate", func(w http.ResponseWriter, r *http.Request) {
@@ -19,6 +19,7 @@ package gitlab
 import (
 	"fmt"
 	"net/http"
+	"net/url"
 	"time"
 )
 
@@ -270,6 +271,7 @@ func (s *PipelineSchedulesService) DeletePipelineSchedule(pid interface{}, sched
 }
 
 // RunPipelineSchedule triggers a new scheduled pipeline to run immediately.
+// The pipeline is created asynchronously, so no pipeline is returned.
 //
 // Gitlab API docs:
 // https://docs.gitlab.com/ee/api/pipeline_schedules.html#run-a-scheduled-pipeline-immediately
@@ -334,7 +336,8 @@ type EditPipelineScheduleVariableOptions struct {
 	VariableType *VariableTypeValue `url:"variable_type,omitempty" json:"variable_type,omitempty"`
 }
 
-// EditPipelineScheduleVariable creates a pipeline schedule variable.
+// EditPipelineScheduleVariable updates the value of a pipeline schedule
+// variable.
 //
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/pipeline_schedules.html#edit-a-pipeline-schedule-variable
@@ -343,7 +346,7 @@ func (s *PipelineSchedulesService) EditPipelineScheduleVariable(pid interface{},
 	if err != nil {
 		return nil, nil, err
 	}
-	u := fmt.Sprintf("projects/%s/pipeline_schedules/%d/variables/%s", PathEscape(project), schedule, key)
+	u := fmt.Sprintf("projects/%s/pipeline_schedules/%d/variables/%s", PathEscape(project), schedule, url.PathEscape(key))
 
 	req, err := s.client.NewRequest(http.MethodPut, u, opt, options)
 	if err != nil {
@@ -359,7 +362,8 @@ func (s *PipelineSchedulesService) EditPipelineScheduleVariable(pid interface{},
 	return p, resp, nil
 }
 
-// DeletePipelineScheduleVariable creates a pipeline schedule variable.
+// DeletePipelineScheduleVariable deletes a pipeline schedule variable and
+// returns the deleted variable.
 //
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/pipeline_schedules.html#delete-a-pipeline-schedule-variable
@@ -368,7 +372,7 @@ func (s *PipelineSchedulesService) DeletePipelineScheduleVariable(pid interface{
 	if err != nil {
 		return nil, nil, err
 	}
-	u := fmt.Sprintf("projects/%s/pipeline_schedules/%d/variables/%s", PathEscape(project), schedule, key)
+	u := fmt.Sprintf("projects/%s/pipeline_schedules/%d/variables/%s", PathEscape(project), schedule, url.PathEscape(key))
 
 	req, err := s.client.NewRequest(http.MethodDelete, u, nil, options)
 	if err != nil {
@@ -19,6 +19,7 @@ package gitlab
 import (
 	"fmt"
 	"net/http"
+	"reflect"
 	"testing"
 )
 
@@ -40,3 +41,74 @@ func TestRunPipelineSchedule(t *testing.T) {
 		t.Errorf("PipelineSchedules.RunPipelineSchedule returned status %v, want %v", res.StatusCode, http.StatusCreated)
 	}
 }
+
+func TestRunPipelineScheduleNoContent(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/1/pipeline_schedules/1/play", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	res, err := client.PipelineSchedules.RunPipelineSchedule(1, 1)
+	if err != nil {
+		t.Errorf("PipelineSchedules.RunPipelineSchedule returned error: %v", err)
+	}
+
+	if res.StatusCode != http.StatusNoContent {
+		t.Errorf("PipelineSchedules.RunPipelineSchedule returned status %v, want %v", res.StatusCode, http.StatusNoContent)
+	}
+}
+
+func TestPipelineScheduleVariables(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/1/pipeline_schedules/2/variables", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testBody(t, r, `{"key":"DEPLOY_ENV","value":"staging","variable_type":"env_var"}`)
+		fmt.Fprint(w, `{"key":"DEPLOY_ENV","value":"staging","variable_type":"env_var"}`)
+	})
+	mux.HandleFunc("/api/v4/projects/1/pipeline_schedules/2/variables/DEPLOY_ENV", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodPut:
+			testBody(t, r, `{"value":"production"}`)
+			fmt.Fprint(w, `{"key":"DEPLOY_ENV","value":"production","variable_type":"env_var"}`)
+		case http.MethodDelete:
+			fmt.Fprint(w, `{"key":"DEPLOY_ENV","value":"production","variable_type":"env_var"}`)
+		default:
+			t.Errorf("unexpected request method %s", r.Method)
+		}
+	})
+
+	v, _, err := client.PipelineSchedules.CreatePipelineScheduleVariable(1, 2, &CreatePipelineScheduleVariableOptions{
+		Key:          Ptr("DEPLOY_ENV"),
+		Value:        Ptr("staging"),
+		VariableType: Ptr(EnvVariableType),
+	})
+	if err != nil {
+		t.Fatalf("PipelineSchedules.CreatePipelineScheduleVariable returned error: %v", err)
+	}
+	want := &PipelineVariable{Key: "DEPLOY_ENV", Value: "staging", VariableType: EnvVariableType}
+	if !reflect.DeepEqual(want, v) {
+		t.Errorf("PipelineSchedules.CreatePipelineScheduleVariable returned %+v, want %+v", v, want)
+	}
+
+	v, _, err = client.PipelineSchedules.EditPipelineScheduleVariable(1, 2, "DEPLOY_ENV", &EditPipelineScheduleVariableOptions{
+		Value: Ptr("production"),
+	})
+	if err != nil {
+		t.Fatalf("PipelineSchedules.EditPipelineScheduleVariable returned error: %v", err)
+	}
+	want.Value = "production"
+	if !reflect.DeepEqual(want, v) {
+		t.Errorf("PipelineSchedules.EditPipelineScheduleVariable returned %+v, want %+v", v, want)
+	}
+
+	v, _, err = client.PipelineSchedules.DeletePipelineScheduleVariable(1, 2, "DEPLOY_ENV")
+	if err != nil {
+		t.Fatalf("PipelineSchedules.DeletePipelineScheduleVariable returned error: %v", err)
+	}
+	if !reflect.DeepEqual(want, v) {
+		t.Errorf("PipelineSchedules.DeletePipelineScheduleVariable returned %+v, want %+v", v, want)
+	}
+}
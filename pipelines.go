@@ -17,11 +17,20 @@
 package gitlab
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"net/http"
+	"regexp"
+	"sort"
+	"strings"
 	"time"
 )
 
+// ErrPipelinesForbidden is returned when the pipelines of a project cannot be
+// listed or canceled due to insufficient permissions.
+var ErrPipelinesForbidden = errors.New("insufficient permissions to manage the pipelines of the project")
+
 // PipelinesService handles communication with the repositories related
 // methods of the GitLab API.
 //
@@ -132,6 +141,41 @@ func (p PipelineTestReport) String() string {
 	return Stringify(p)
 }
 
+// PipelineTestReportSummary contains a summary of the test report of a
+// pipeline.
+type PipelineTestReportSummary struct {
+	Total      *PipelineTotalSummary       `json:"total"`
+	TestSuites []*PipelineTestSuiteSummary `json:"test_suites"`
+}
+
+// PipelineTotalSummary contains the total summary of a pipeline test report.
+type PipelineTotalSummary struct {
+	Time       float64 `json:"time"`
+	Count      int     `json:"count"`
+	Success    int     `json:"success"`
+	Failed     int     `json:"failed"`
+	Skipped    int     `json:"skipped"`
+	Error      int     `json:"error"`
+	SuiteError string  `json:"suite_error"`
+}
+
+// PipelineTestSuiteSummary contains the summary of a single test suite.
+type PipelineTestSuiteSummary struct {
+	Name         string  `json:"name"`
+	TotalTime    float64 `json:"total_time"`
+	TotalCount   int     `json:"total_count"`
+	SuccessCount int     `json:"success_count"`
+	FailedCount  int     `json:"failed_count"`
+	SkippedCount int     `json:"skipped_count"`
+	ErrorCount   int     `json:"error_count"`
+	BuildIDs     []int   `json:"build_ids"`
+	SuiteError   string  `json:"suite_error"`
+}
+
+func (p PipelineTestReportSummary) String() string {
+	return Stringify(p)
+}
+
 // PipelineInfo shows the basic entities of a pipeline, mostly used as fields
 // on other assets, like Commit.
 type PipelineInfo struct {
@@ -272,6 +316,32 @@ func (s *PipelinesService) GetPipelineTestReport(pid interface{}, pipeline int,
 	return p, resp, nil
 }
 
+// GetPipelineTestReportSummary gets the test report summary of a single
+// project pipeline.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/pipelines.html#get-a-pipelines-test-report-summary
+func (s *PipelinesService) GetPipelineTestReportSummary(pid interface{}, pipeline int, options ...RequestOptionFunc) (*PipelineTestReportSummary, *Response, error) {
+	project, err := parseID(pid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("projects/%s/pipelines/%d/test_report_summary", PathEscape(project), pipeline)
+
+	req, err := s.client.NewRequest(http.MethodGet, u, nil, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	p := new(PipelineTestReportSummary)
+	resp, err := s.client.Do(req, p)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return p, resp, nil
+}
+
 // GetLatestPipelineOptions represents the available GetLatestPipeline() options.
 //
 // GitLab API docs:
@@ -398,6 +468,95 @@ func (s *PipelinesService) CancelPipelineBuild(pid interface{}, pipeline int, op
 	return p, resp, nil
 }
 
+// ListUserRunningPipelines gets the running and pending pipelines of a
+// project that were triggered by the given user, newest first. The Username
+// and Status fields of opt are set by this method; all pages are fetched.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/pipelines.html#list-project-pipelines
+func (s *PipelinesService) ListUserRunningPipelines(pid interface{}, userID int, opt *ListProjectPipelinesOptions, options ...RequestOptionFunc) ([]*PipelineInfo, *Response, error) {
+	u, resp, err := s.client.Users.GetUser(userID, GetUsersOptions{}, options...)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	lopt := new(ListProjectPipelinesOptions)
+	if opt != nil {
+		*lopt = *opt
+	}
+	lopt.Username = Ptr(u.Username)
+
+	return s.listActivePipelines(pid, lopt, options...)
+}
+
+// CancelRedundantPipelines cancels all running and pending pipelines for the
+// given ref, except for the newest one. It returns the canceled pipelines.
+// When a pipeline cannot be canceled, the pipelines canceled so far are
+// returned together with the error.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/pipelines.html#cancel-a-pipelines-jobs
+func (s *PipelinesService) CancelRedundantPipelines(ctx context.Context, pid interface{}, ref string, options ...RequestOptionFunc) ([]*Pipeline, *Response, error) {
+	options = append([]RequestOptionFunc{WithContext(ctx)}, options...)
+
+	ps, resp, err := s.listActivePipelines(pid, &ListProjectPipelinesOptions{Ref: Ptr(ref)}, options...)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	var canceled []*Pipeline
+	for i := 1; i < len(ps); i++ {
+		p, r, err := s.CancelPipelineBuild(pid, ps[i].ID, options...)
+		resp = r
+		if err != nil {
+			return canceled, resp, pipelinesError(resp, err)
+		}
+		canceled = append(canceled, p)
+	}
+
+	return canceled, resp, nil
+}
+
+// listActivePipelines gets all running and pending pipelines matching opt,
+// ordered from newest to oldest.
+func (s *PipelinesService) listActivePipelines(pid interface{}, opt *ListProjectPipelinesOptions, options ...RequestOptionFunc) ([]*PipelineInfo, *Response, error) {
+	var pipelines []*PipelineInfo
+	var resp *Response
+
+	for _, status := range []BuildStateValue{Running, Pending} {
+		opt.Status = Ptr(status)
+		opt.Page = 0
+		if opt.PerPage == 0 {
+			opt.PerPage = 100
+		}
+		for {
+			ps, r, err := s.ListProjectPipelines(pid, opt, options...)
+			resp = r
+			if err != nil {
+				return nil, resp, pipelinesError(resp, err)
+			}
+			pipelines = append(pipelines, ps...)
+			if r.NextPage == 0 {
+				break
+			}
+			opt.Page = r.NextPage
+		}
+	}
+
+	sort.Slice(pipelines, func(i, j int) bool {
+		return pipelines[i].ID > pipelines[j].ID
+	})
+
+	return pipelines, resp, nil
+}
+
+func pipelinesError(resp *Response, err error) error {
+	if resp != nil && resp.StatusCode == http.StatusForbidden {
+		return &sentinelError{sentinel: ErrPipelinesForbidden, err: err}
+	}
+	return err
+}
+
 // DeletePipeline deletes an existing pipeline.
 //
 // GitLab API docs:
@@ -417,6 +576,152 @@ func (s *PipelinesService) DeletePipeline(pid interface{}, pipeline int, options
 	return s.client.Do(req, nil)
 }
 
+// DeletePipelinesOlderThanOptions represents the available
+// DeletePipelinesOlderThan() options. When KeepProtectedRefs is set, pipelines
+// for protected branches and tags are not deleted.
+type DeletePipelinesOlderThanOptions struct {
+	KeepProtectedRefs *bool
+}
+
+// DeletePipelinesResult represents the outcome of DeletePipelinesOlderThan.
+// Deleted holds the IDs of the deleted pipelines, while Failed holds the error
+// for every pipeline ID that could not be deleted.
+type DeletePipelinesResult struct {
+	Deleted []int
+	Failed  map[int]error
+}
+
+// DeletePipelinesOlderThan deletes all pipelines of a project that were last
+// updated before the given time. A failure to delete one pipeline does not
+// abort the others; it is recorded in the Failed map of the result instead.
+// When the user is not allowed to delete pipelines, or ctx is done, the
+// result so far is returned together with the error, which matches
+// ErrPipelinesForbidden in the former case.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/pipelines.html#delete-a-pipeline
+func (s *PipelinesService) DeletePipelinesOlderThan(ctx context.Context, pid interface{}, before time.Time, opt *DeletePipelinesOlderThanOptions, options ...RequestOptionFunc) (*DeletePipelinesResult, *Response, error) {
+	options = append([]RequestOptionFunc{WithContext(ctx)}, options...)
+
+	var pipelines []*PipelineInfo
+	var resp *Response
+
+	// Collect all pipelines before deleting any, as deleting them while
+	// paginating would shift the pages.
+	lopt := &ListProjectPipelinesOptions{
+		ListOptions:   ListOptions{PerPage: 100},
+		UpdatedBefore: &before,
+	}
+	for {
+		ps, r, err := s.ListProjectPipelines(pid, lopt, options...)
+		resp = r
+		if err != nil {
+			return nil, resp, pipelinesError(resp, err)
+		}
+		pipelines = append(pipelines, ps...)
+		if r.NextPage == 0 {
+			break
+		}
+		lopt.Page = r.NextPage
+	}
+
+	var protected []string
+	if opt != nil && opt.KeepProtectedRefs != nil && *opt.KeepProtectedRefs {
+		var err error
+		protected, resp, err = s.listProtectedRefs(pid, options...)
+		if err != nil {
+			return nil, resp, err
+		}
+	}
+
+	result := &DeletePipelinesResult{Failed: make(map[int]error)}
+	for _, p := range pipelines {
+		if err := ctx.Err(); err != nil {
+			return result, resp, err
+		}
+		// Filter on the update time as well, so pipelines updated while
+		// listing are kept.
+		if p.UpdatedAt != nil && !p.UpdatedAt.Before(before) {
+			continue
+		}
+		if protectedRef(protected, p.Ref) {
+			continue
+		}
+
+		r, err := s.DeletePipeline(pid, p.ID, options...)
+		if err != nil {
+			if r != nil && r.StatusCode == http.StatusForbidden {
+				return result, r, pipelinesError(r, err)
+			}
+			result.Failed[p.ID] = err
+			continue
+		}
+		resp = r
+		result.Deleted = append(result.Deleted, p.ID)
+	}
+
+	return result, resp, nil
+}
+
+// listProtectedRefs gets the names of all protected branches and tags of a
+// project, which may contain wildcards.
+func (s *PipelinesService) listProtectedRefs(pid interface{}, options ...RequestOptionFunc) ([]string, *Response, error) {
+	var refs []string
+	var resp *Response
+
+	bopt := &ListProtectedBranchesOptions{ListOptions: ListOptions{PerPage: 100}}
+	for {
+		bs, r, err := s.client.ProtectedBranches.ListProtectedBranches(pid, bopt, options...)
+		resp = r
+		if err != nil {
+			return nil, resp, err
+		}
+		for _, b := range bs {
+			refs = append(refs, b.Name)
+		}
+		if r.NextPage == 0 {
+			break
+		}
+		bopt.Page = r.NextPage
+	}
+
+	topt := &ListProtectedTagsOptions{PerPage: 100}
+	for {
+		ts, r, err := s.client.ProtectedTags.ListProtectedTags(pid, topt, options...)
+		resp = r
+		if err != nil {
+			return nil, resp, err
+		}
+		for _, t := range ts {
+			refs = append(refs, t.Name)
+		}
+		if r.NextPage == 0 {
+			break
+		}
+		topt.Page = r.NextPage
+	}
+
+	return refs, resp, nil
+}
+
+// protectedRef reports whether ref matches any of the given protected ref
+// names, where a * matches any sequence of characters.
+func protectedRef(protected []string, ref string) bool {
+	for _, name := range protected {
+		if !strings.Contains(name, "*") {
+			if name == ref {
+				return true
+			}
+			continue
+		}
+		pattern := "^" + strings.ReplaceAll(regexp.QuoteMeta(name), `\*`, ".*") + "$"
+		if ok, _ := regexp.MatchString(pattern, ref); ok {
+			return true
+		}
+	}
+	return false
+}
+
 // UpdatePipelineMetadataOptions represents the available UpdatePipelineMetadata()
 // options.
 //
@@ -17,12 +17,15 @@
 package gitlab
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestListProjectPipelines(t *testing.T) {
@@ -169,6 +172,63 @@ func TestGetPipelineTestReport(t *testing.T) {
 	}
 }
 
+func TestGetPipelineTestReportSummary(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/1/pipelines/123456/test_report_summary", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{
+			"total": {
+				"time": 1904,
+				"count": 3363,
+				"success": 3351,
+				"failed": 0,
+				"skipped": 12,
+				"error": 0,
+				"suite_error": null
+			},
+			"test_suites": [
+				{
+					"name": "test",
+					"total_time": 1904,
+					"total_count": 3363,
+					"success_count": 3351,
+					"failed_count": 0,
+					"skipped_count": 12,
+					"error_count": 0,
+					"build_ids": [66004],
+					"suite_error": null
+				}
+			]
+		}`)
+	})
+
+	summary, _, err := client.Pipelines.GetPipelineTestReportSummary(1, 123456)
+	if err != nil {
+		t.Errorf("Pipelines.GetPipelineTestReportSummary returned error: %v", err)
+	}
+
+	want := &PipelineTestReportSummary{
+		Total: &PipelineTotalSummary{
+			Time:    1904,
+			Count:   3363,
+			Success: 3351,
+			Skipped: 12,
+		},
+		TestSuites: []*PipelineTestSuiteSummary{{
+			Name:         "test",
+			TotalTime:    1904,
+			TotalCount:   3363,
+			SuccessCount: 3351,
+			SkippedCount: 12,
+			BuildIDs:     []int{66004},
+		}},
+	}
+	if !reflect.DeepEqual(want, summary) {
+		t.Errorf("Pipelines.GetPipelineTestReportSummary returned %+v, want %+v", summary, want)
+	}
+}
+
 func TestGetLatestPipeline(t *testing.T) {
 	mux, client := setup(t)
 
@@ -259,6 +319,160 @@ func TestCancelPipelineBuild(t *testing.T) {
 	}
 }
 
+func TestCancelRedundantPipelines(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/1/pipelines", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		switch r.URL.Query().Get("status") {
+		case "running":
+			testParams(t, r, "per_page=100&ref=main&status=running")
+			fmt.Fprint(w, `[{"id":10,"ref":"main","status":"running"},{"id":12,"ref":"main","status":"running"}]`)
+		case "pending":
+			fmt.Fprint(w, `[{"id":11,"ref":"main","status":"pending"}]`)
+		default:
+			t.Errorf("unexpected status filter %q", r.URL.Query().Get("status"))
+		}
+	})
+
+	var canceledIDs []int
+	for _, id := range []int{10, 11, 12} {
+		id := id
+		mux.HandleFunc(fmt.Sprintf("/api/v4/projects/1/pipelines/%d/cancel", id), func(w http.ResponseWriter, r *http.Request) {
+			testMethod(t, r, http.MethodPost)
+			canceledIDs = append(canceledIDs, id)
+			fmt.Fprintf(w, `{"id":%d,"status":"canceled"}`, id)
+		})
+	}
+
+	canceled, _, err := client.Pipelines.CancelRedundantPipelines(context.Background(), 1, "main")
+	require.NoError(t, err)
+
+	assert.Equal(t, []int{11, 10}, canceledIDs)
+	assert.Len(t, canceled, 2)
+}
+
+func TestCancelRedundantPipelinesForbidden(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/1/pipelines", func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, `{"message":"403 Forbidden"}`, http.StatusForbidden)
+	})
+
+	_, resp, err := client.Pipelines.CancelRedundantPipelines(context.Background(), 1, "main")
+	assert.ErrorIs(t, err, ErrPipelinesForbidden)
+	assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+}
+
+func TestDeletePipelinesOlderThan(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/1/pipelines", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testParams(t, r, "per_page=100&updated_before=2024-06-01T00%3A00%3A00Z")
+		fmt.Fprint(w, `[
+			{"id":1,"ref":"feature","updated_at":"2024-03-01T00:00:00Z"},
+			{"id":2,"ref":"main","updated_at":"2024-04-01T00:00:00Z"},
+			{"id":3,"ref":"feature","updated_at":"2024-06-15T00:00:00Z"}
+		]`)
+	})
+
+	var deletedIDs []int
+	for _, id := range []int{1, 2, 3} {
+		id := id
+		mux.HandleFunc(fmt.Sprintf("/api/v4/projects/1/pipelines/%d", id), func(w http.ResponseWriter, r *http.Request) {
+			testMethod(t, r, http.MethodDelete)
+			deletedIDs = append(deletedIDs, id)
+			w.WriteHeader(http.StatusNoContent)
+		})
+	}
+
+	before := time.Date(2024, time.June, 1, 0, 0, 0, 0, time.UTC)
+	result, _, err := client.Pipelines.DeletePipelinesOlderThan(context.Background(), 1, before, nil)
+	require.NoError(t, err)
+
+	assert.Equal(t, []int{1, 2}, deletedIDs)
+	assert.Equal(t, []int{1, 2}, result.Deleted)
+	assert.Empty(t, result.Failed)
+}
+
+func TestDeletePipelinesOlderThanKeepProtectedRefs(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/1/pipelines", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `[
+			{"id":1,"ref":"feature"},
+			{"id":2,"ref":"main"},
+			{"id":3,"ref":"release/1.0"},
+			{"id":4,"ref":"v1.0.0"}
+		]`)
+	})
+	mux.HandleFunc("/api/v4/projects/1/protected_branches", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `[{"id":1,"name":"main"},{"id":2,"name":"release/*"}]`)
+	})
+	mux.HandleFunc("/api/v4/projects/1/protected_tags", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `[{"name":"v*"}]`)
+	})
+	mux.HandleFunc("/api/v4/projects/1/pipelines/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodDelete)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	before := time.Date(2024, time.June, 1, 0, 0, 0, 0, time.UTC)
+	result, _, err := client.Pipelines.DeletePipelinesOlderThan(context.Background(), 1, before, &DeletePipelinesOlderThanOptions{
+		KeepProtectedRefs: Ptr(true),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []int{1}, result.Deleted)
+}
+
+func TestDeletePipelinesOlderThanForbidden(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/1/pipelines", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `[{"id":1,"ref":"feature"},{"id":2,"ref":"feature"}]`)
+	})
+	mux.HandleFunc("/api/v4/projects/1/pipelines/1", func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, `{"message":"403 Forbidden"}`, http.StatusForbidden)
+	})
+	mux.HandleFunc("/api/v4/projects/1/pipelines/2", func(w http.ResponseWriter, r *http.Request) {
+		t.Error("unexpected request to delete pipeline 2")
+	})
+
+	result, resp, err := client.Pipelines.DeletePipelinesOlderThan(context.Background(), 1, time.Now(), nil)
+	assert.ErrorIs(t, err, ErrPipelinesForbidden)
+	assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+	assert.Empty(t, result.Deleted)
+}
+
+func TestListUserRunningPipelines(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/users/7", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{"id":7,"username":"alice"}`)
+	})
+	mux.HandleFunc("/api/v4/projects/1/pipelines", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		q := r.URL.Query()
+		assert.Equal(t, "alice", q.Get("username"))
+		if q.Get("status") == "running" {
+			fmt.Fprint(w, `[{"id":3,"status":"running"}]`)
+			return
+		}
+		fmt.Fprint(w, `[{"id":4,"status":"pending"}]`)
+	})
+
+	pipelines, _, err := client.Pipelines.ListUserRunningPipelines(1, 7, nil)
+	require.NoError(t, err)
+
+	want := []*PipelineInfo{{ID: 4, Status: "pending"}, {ID: 3, Status: "running"}}
+	assert.Equal(t, want, pipelines)
+}
+
 func TestDeletePipeline(t *testing.T) {
 	mux, client := setup(t)
 
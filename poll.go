@@ -0,0 +1,90 @@
+//
+// Copyright 2021, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package gitlab
+
+import (
+	"context"
+	"math/rand"
+	"time"
+)
+
+// PollOptionFunc can be passed to Poll to customize the polling behavior.
+type PollOptionFunc func(*pollOptions)
+
+type pollOptions struct {
+	jitter float64
+	clock  pollClock
+}
+
+// pollClock abstracts waiting so tests can use a fake clock.
+type pollClock interface {
+	After(d time.Duration) <-chan time.Time
+}
+
+type realPollClock struct{}
+
+func (realPollClock) After(d time.Duration) <-chan time.Time {
+	return time.After(d)
+}
+
+// WithPollJitter adds a random delay of up to factor times the interval to
+// every wait. This prevents a thundering herd when many workers poll the
+// same GitLab instance. A factor of 0.5 for example makes every wait last
+// between 1 and 1.5 times the interval.
+func WithPollJitter(factor float64) PollOptionFunc {
+	return func(o *pollOptions) {
+		o.jitter = factor
+	}
+}
+
+// Poll calls fn right away and then once every interval, until fn reports
+// it is done, fn returns an error or ctx is done. It returns the error
+// returned by fn, the error of ctx or nil once fn is done.
+func Poll(ctx context.Context, interval time.Duration, fn func() (done bool, err error), options ...PollOptionFunc) error {
+	opts := &pollOptions{clock: realPollClock{}}
+	for _, opt := range options {
+		opt(opts)
+	}
+
+	// rnd is used to generate the jitter added to every wait.
+	rnd := rand.New(rand.NewSource(time.Now().UnixNano()))
+
+	for {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		done, err := fn()
+		if err != nil {
+			return err
+		}
+		if done {
+			return nil
+		}
+
+		wait := interval
+		if opts.jitter > 0 {
+			wait += time.Duration(rnd.Float64() * opts.jitter * float64(interval))
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-opts.clock.After(wait):
+		}
+	}
+}
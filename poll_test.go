@@ -0,0 +1,121 @@
+//
+// Copyright 2021, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package gitlab
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// fakePollClock fires immediately and records all requested waits.
+type fakePollClock struct {
+	waits []time.Duration
+}
+
+func (c *fakePollClock) After(d time.Duration) <-chan time.Time {
+	c.waits = append(c.waits, d)
+	ch := make(chan time.Time, 1)
+	ch <- time.Time{}
+	return ch
+}
+
+func withFakePollClock(c *fakePollClock) PollOptionFunc {
+	return func(o *pollOptions) {
+		o.clock = c
+	}
+}
+
+func TestPollUntilDone(t *testing.T) {
+	clock := &fakePollClock{}
+
+	calls := 0
+	err := Poll(context.Background(), time.Second, func() (bool, error) {
+		calls++
+		return calls == 3, nil
+	}, withFakePollClock(clock))
+
+	require.NoError(t, err)
+	require.Equal(t, 3, calls)
+	require.Equal(t, []time.Duration{time.Second, time.Second}, clock.waits)
+}
+
+func TestPollStopsOnError(t *testing.T) {
+	clock := &fakePollClock{}
+	wantErr := errors.New("pipeline failed")
+
+	calls := 0
+	err := Poll(context.Background(), time.Second, func() (bool, error) {
+		calls++
+		return false, wantErr
+	}, withFakePollClock(clock))
+
+	require.Equal(t, wantErr, err)
+	require.Equal(t, 1, calls)
+	require.Empty(t, clock.waits)
+}
+
+func TestPollContextCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	clock := &fakePollClock{}
+
+	calls := 0
+	err := Poll(ctx, time.Second, func() (bool, error) {
+		calls++
+		if calls == 2 {
+			cancel()
+		}
+		return false, nil
+	}, withFakePollClock(clock))
+
+	require.Equal(t, context.Canceled, err)
+	require.Equal(t, 2, calls)
+}
+
+func TestPollContextCanceledWhileWaiting(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	// Uses the real clock with a long interval, so this only returns
+	// promptly when the cancellation is honored.
+	err := Poll(ctx, time.Hour, func() (bool, error) {
+		t.Fatal("fn should not be called when the context is already done")
+		return false, nil
+	})
+
+	require.Equal(t, context.Canceled, err)
+}
+
+func TestPollWithJitter(t *testing.T) {
+	clock := &fakePollClock{}
+
+	calls := 0
+	err := Poll(context.Background(), time.Second, func() (bool, error) {
+		calls++
+		return calls == 10, nil
+	}, withFakePollClock(clock), WithPollJitter(0.5))
+
+	require.NoError(t, err)
+	require.Len(t, clock.waits, 9)
+	for _, wait := range clock.waits {
+		require.GreaterOrEqual(t, wait, time.Second)
+		require.Less(t, wait, 1500*time.Millisecond)
+	}
+}
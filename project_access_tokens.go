@@ -84,6 +84,57 @@ func (s *ProjectAccessTokensService) ListProjectAccessTokens(pid interface{}, op
 	return pats, resp, nil
 }
 
+// ExpiringProjectAccessToken represents a project access token that expires
+// soon, together with the full path of the project it belongs to.
+type ExpiringProjectAccessToken struct {
+	*ProjectAccessToken
+	ProjectPath string `json:"project_path"`
+}
+
+func (t ExpiringProjectAccessToken) String() string {
+	return Stringify(t)
+}
+
+// ListExpiringProjectAccessTokens gets all access tokens of a project that
+// expire before the given time, including tokens that already expired.
+// Revoked tokens and tokens without an expiry date are left out.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/project_access_tokens.html#list-project-access-tokens
+func (s *ProjectAccessTokensService) ListExpiringProjectAccessTokens(pid interface{}, before time.Time, options ...RequestOptionFunc) ([]*ExpiringProjectAccessToken, *Response, error) {
+	p, resp, err := s.client.Projects.GetProject(pid, nil, options...)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	var expiring []*ExpiringProjectAccessToken
+
+	opt := &ListProjectAccessTokensOptions{PerPage: 100}
+	for {
+		ts, r, err := s.ListProjectAccessTokens(pid, opt, options...)
+		if err != nil {
+			return nil, r, err
+		}
+		for _, t := range ts {
+			if t.Revoked || t.ExpiresAt == nil || !time.Time(*t.ExpiresAt).Before(before) {
+				continue
+			}
+			expiring = append(expiring, &ExpiringProjectAccessToken{
+				ProjectAccessToken: t,
+				ProjectPath:        p.PathWithNamespace,
+			})
+		}
+		resp = r
+
+		if r.NextPage == 0 {
+			break
+		}
+		opt.Page = r.NextPage
+	}
+
+	return expiring, resp, nil
+}
+
 // GetProjectAccessToken gets a single project access tokens in a project.
 //
 // GitLab API docs:
@@ -174,7 +225,7 @@ func (s *ProjectAccessTokensService) RotateProjectAccessToken(pid interface{}, i
 	pat := new(ProjectAccessToken)
 	resp, err := s.client.Do(req, pat)
 	if err != nil {
-		return nil, resp, err
+		return nil, resp, rotateAccessTokenError(resp, err)
 	}
 
 	return pat, resp, nil
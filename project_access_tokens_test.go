@@ -17,6 +17,7 @@
 package gitlab
 
 import (
+	"fmt"
 	"net/http"
 	"reflect"
 	"testing"
@@ -78,6 +79,45 @@ func TestListProjectAccessTokens(t *testing.T) {
 	}
 }
 
+func TestListExpiringProjectAccessTokens(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{"id":1,"path_with_namespace":"group/project"}`)
+	})
+	mux.HandleFunc("/api/v4/projects/1/access_tokens", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		if r.URL.Query().Get("page") == "2" {
+			fmt.Fprint(w, `[
+				{"id":3,"name":"revoked","expires_at":"2024-06-10","active":false,"revoked":true},
+				{"id":4,"name":"later","expires_at":"2024-08-01","active":true}
+			]`)
+			return
+		}
+		w.Header().Set("X-Next-Page", "2")
+		fmt.Fprint(w, `[
+			{"id":1,"name":"expiring","expires_at":"2024-06-20","active":true},
+			{"id":2,"name":"no expiry","expires_at":null,"active":true}
+		]`)
+	})
+
+	now := time.Date(2024, time.June, 1, 0, 0, 0, 0, time.UTC)
+	tokens, _, err := client.ProjectAccessTokens.ListExpiringProjectAccessTokens(1, now.AddDate(0, 0, 30))
+	if err != nil {
+		t.Fatalf("ProjectAccessTokens.ListExpiringProjectAccessTokens returned error: %v", err)
+	}
+
+	expiresAt := ISOTime(time.Date(2024, time.June, 20, 0, 0, 0, 0, time.UTC))
+	want := []*ExpiringProjectAccessToken{{
+		ProjectAccessToken: &ProjectAccessToken{ID: 1, Name: "expiring", ExpiresAt: &expiresAt, Active: true},
+		ProjectPath:        "group/project",
+	}}
+	if !reflect.DeepEqual(want, tokens) {
+		t.Errorf("ProjectAccessTokens.ListExpiringProjectAccessTokens returned %+v, want %+v", tokens, want)
+	}
+}
+
 func TestGetProjectAccessToken(t *testing.T) {
 	mux, client := setup(t)
 
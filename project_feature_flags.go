@@ -32,7 +32,7 @@ type ProjectFeatureFlag struct {
 //
 // GitLab API docs: https://docs.gitlab.com/ee/api/feature_flags.html
 type ProjectFeatureFlagScope struct {
-	ID               int    `json:"id"`
+	ID               int    `json:"id,omitempty"`
 	EnvironmentScope string `json:"environment_scope"`
 }
 
@@ -46,8 +46,25 @@ type ProjectFeatureFlagStrategy struct {
 	Scopes     []*ProjectFeatureFlagScope           `json:"scopes"`
 }
 
+// List of available feature flag strategy names.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/operations/feature_flags.html#feature-flag-strategies
+const (
+	FeatureFlagStrategyDefault              = "default"
+	FeatureFlagStrategyGradualRolloutUserID = "gradualRolloutUserId"
+	FeatureFlagStrategyFlexibleRollout      = "flexibleRollout"
+	FeatureFlagStrategyUserWithID           = "userWithId"
+	FeatureFlagStrategyGitLabUserList       = "gitlabUserList"
+)
+
 // ProjectFeatureFlagStrategyParameter is used in updating and creating feature flags
 //
+// The parameters used depend on the strategy:
+//   - gradualRolloutUserId: GroupID and Percentage
+//   - flexibleRollout: GroupID, Rollout and Stickiness
+//   - userWithId: UserIDs, a comma separated list of user IDs
+//
 // GitLab API docs: https://docs.gitlab.com/ee/api/feature_flags.html
 type ProjectFeatureFlagStrategyParameter struct {
 	GroupID    string `json:"groupId,omitempty"`
@@ -156,7 +173,7 @@ type FeatureFlagStrategyOptions struct {
 // https://docs.gitlab.com/ee/api/feature_flags.html#create-a-feature-flag
 type ProjectFeatureFlagScopeOptions struct {
 	ID               *int    `url:"id,omitempty" json:"id,omitempty"`
-	EnvironmentScope *string `url:"id,omitempty" json:"environment_scope,omitempty"`
+	EnvironmentScope *string `url:"environment_scope,omitempty" json:"environment_scope,omitempty"`
 }
 
 // CreateProjectFeatureFlag creates a feature flag
@@ -236,6 +236,43 @@ func TestUpdateProjectFeatureFlag(t *testing.T) {
 	assert.Equal(t, expected, actual)
 }
 
+func TestCreateProjectFeatureFlagWithStrategies(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/1/feature_flags", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testBody(t, r, `{"name":"awesome_feature","version":"new_version_flag","strategies":[{"name":"flexibleRollout","parameters":{"groupId":"default","rollout":"50","stickiness":"default"},"scopes":[{"environment_scope":"production"}]},{"name":"userWithId","parameters":{"userIds":"1,2"}}]}`)
+		mustWriteHTTPResponse(t, w, "testdata/create_project_feature_flag.json")
+	})
+
+	_, _, err := client.ProjectFeatureFlags.CreateProjectFeatureFlag(1, &CreateProjectFeatureFlagOptions{
+		Name:    Ptr("awesome_feature"),
+		Version: Ptr("new_version_flag"),
+		Strategies: &[]*FeatureFlagStrategyOptions{
+			{
+				Name: Ptr(FeatureFlagStrategyFlexibleRollout),
+				Parameters: &ProjectFeatureFlagStrategyParameter{
+					GroupID:    "default",
+					Rollout:    "50",
+					Stickiness: "default",
+				},
+				Scopes: &[]*ProjectFeatureFlagScope{
+					{EnvironmentScope: "production"},
+				},
+			},
+			{
+				Name: Ptr(FeatureFlagStrategyUserWithID),
+				Parameters: &ProjectFeatureFlagStrategyParameter{
+					UserIDs: "1,2",
+				},
+			},
+		},
+	})
+	if err != nil {
+		t.Errorf("ProjectFeatureFlags.CreateProjectFeatureFlag returned error: %v", err)
+	}
+}
+
 func TestDeleteProjectFeatureFlag(t *testing.T) {
 	mux, client := setup(t)
 
@@ -151,7 +151,7 @@ func (s *ProjectMembersService) GetInheritedProjectMember(pid interface{}, user
 type AddProjectMemberOptions struct {
 	UserID       interface{}       `url:"user_id,omitempty" json:"user_id,omitempty"`
 	AccessLevel  *AccessLevelValue `url:"access_level,omitempty" json:"access_level,omitempty"`
-	ExpiresAt    *string           `url:"expires_at,omitempty" json:"expires_at"`
+	ExpiresAt    *string           `url:"expires_at,omitempty" json:"expires_at,omitempty"`
 	MemberRoleID *int              `url:"member_role_id,omitempty" json:"member_role_id,omitempty"`
 }
 
@@ -236,3 +236,131 @@ func (s *ProjectMembersService) DeleteProjectMember(pid interface{}, user int, o
 
 	return s.client.Do(req, nil)
 }
+
+// MemberSourceValue represents where a project membership comes from.
+type MemberSourceValue string
+
+// List of available member sources.
+const (
+	DirectMemberSource    MemberSourceValue = "direct"
+	InheritedMemberSource MemberSourceValue = "inherited"
+	SharedMemberSource    MemberSourceValue = "shared"
+)
+
+// MemberAudit represents a flattened project member, enriched with the source
+// of the membership and the group granting it. It is meant to be exported,
+// for example to a CSV file.
+type MemberAudit struct {
+	UserID        int               `json:"user_id"`
+	Username      string            `json:"username"`
+	Name          string            `json:"name"`
+	State         string            `json:"state"`
+	AccessLevel   AccessLevelValue  `json:"access_level"`
+	ExpiresAt     *ISOTime          `json:"expires_at"`
+	Source        MemberSourceValue `json:"source"`
+	GrantingGroup string            `json:"granting_group"`
+}
+
+func (m MemberAudit) String() string {
+	return Stringify(m)
+}
+
+// ListAllProjectMembersDetailed gets all members of a project, including
+// inherited and shared members, together with the source of each membership.
+// Direct members take precedence over inherited members, which in turn take
+// precedence over members of groups the project is shared with.
+//
+// Note that this method paginates fully through the direct and inherited
+// project members, the members of the project's namespace and the members of
+// every group the project is shared with, so it may issue many requests for
+// large projects. The returned Response is the one of the last request.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/members.html#list-all-members-of-a-group-or-project-including-inherited-and-invited-members
+func (s *ProjectMembersService) ListAllProjectMembersDetailed(pid interface{}, options ...RequestOptionFunc) ([]*MemberAudit, *Response, error) {
+	p, resp, err := s.client.Projects.GetProject(pid, nil, options...)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	direct := make(map[int]bool)
+	opt := &ListProjectMembersOptions{ListOptions: ListOptions{PerPage: 100}}
+	for {
+		pms, resp, err := s.ListProjectMembers(pid, opt, options...)
+		if err != nil {
+			return nil, resp, err
+		}
+		for _, pm := range pms {
+			direct[pm.ID] = true
+		}
+		if resp.NextPage == 0 {
+			break
+		}
+		opt.Page = resp.NextPage
+	}
+
+	// Map users to the group granting them access, starting with the groups
+	// the project is shared with so that inherited memberships win.
+	granting := make(map[int]string)
+	sources := make(map[int]MemberSourceValue)
+	collect := func(gid int, path string, source MemberSourceValue) (*Response, error) {
+		opt := &ListGroupMembersOptions{ListOptions: ListOptions{PerPage: 100}}
+		for {
+			gms, resp, err := s.client.Groups.ListAllGroupMembers(gid, opt, options...)
+			if err != nil {
+				return resp, err
+			}
+			for _, gm := range gms {
+				granting[gm.ID] = path
+				sources[gm.ID] = source
+			}
+			if resp.NextPage == 0 {
+				return resp, nil
+			}
+			opt.Page = resp.NextPage
+		}
+	}
+
+	for _, g := range p.SharedWithGroups {
+		if resp, err := collect(g.GroupID, g.GroupFullPath, SharedMemberSource); err != nil {
+			return nil, resp, err
+		}
+	}
+	if p.Namespace != nil && p.Namespace.Kind == "group" {
+		if resp, err := collect(p.Namespace.ID, p.Namespace.FullPath, InheritedMemberSource); err != nil {
+			return nil, resp, err
+		}
+	}
+
+	var mas []*MemberAudit
+	opt = &ListProjectMembersOptions{ListOptions: ListOptions{PerPage: 100}}
+	for {
+		pms, resp, err := s.ListAllProjectMembers(pid, opt, options...)
+		if err != nil {
+			return nil, resp, err
+		}
+		for _, pm := range pms {
+			ma := &MemberAudit{
+				UserID:      pm.ID,
+				Username:    pm.Username,
+				Name:        pm.Name,
+				State:       pm.State,
+				AccessLevel: pm.AccessLevel,
+				ExpiresAt:   pm.ExpiresAt,
+				Source:      InheritedMemberSource,
+			}
+			switch {
+			case direct[pm.ID]:
+				ma.Source = DirectMemberSource
+			case sources[pm.ID] != "":
+				ma.Source = sources[pm.ID]
+				ma.GrantingGroup = granting[pm.ID]
+			}
+			mas = append(mas, ma)
+		}
+		if resp.NextPage == 0 {
+			return mas, resp, nil
+		}
+		opt.Page = resp.NextPage
+	}
+}
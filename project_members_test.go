@@ -367,3 +367,51 @@ func TestProjectMembersService_DeleteProjectMember(t *testing.T) {
 	require.Error(t, err)
 	require.Equal(t, http.StatusNotFound, resp.StatusCode)
 }
+
+func TestProjectMembersService_ListAllProjectMembersDetailed(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{"id":1,"namespace":{"id":10,"kind":"group","full_path":"acme"},"shared_with_groups":[]}`)
+	})
+	mux.HandleFunc("/api/v4/projects/1/members", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `[{"id":1,"username":"direct","access_level":40}]`)
+	})
+	mux.HandleFunc("/api/v4/projects/1/members/all", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `[
+			{"id":1,"username":"direct","access_level":40},
+			{"id":2,"username":"inherited","access_level":30,"expires_at":"2030-01-01"}
+		]`)
+	})
+	mux.HandleFunc("/api/v4/groups/10/members/all", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `[{"id":2,"username":"inherited","access_level":30}]`)
+	})
+
+	expiresAt, err := ParseISOTime("2030-01-01")
+	require.NoError(t, err)
+
+	want := []*MemberAudit{
+		{
+			UserID:      1,
+			Username:    "direct",
+			AccessLevel: MaintainerPermissions,
+			Source:      DirectMemberSource,
+		},
+		{
+			UserID:        2,
+			Username:      "inherited",
+			AccessLevel:   DeveloperPermissions,
+			ExpiresAt:     &expiresAt,
+			Source:        InheritedMemberSource,
+			GrantingGroup: "acme",
+		},
+	}
+
+	members, _, err := client.ProjectMembers.ListAllProjectMembersDetailed(1)
+	require.NoError(t, err)
+	require.Equal(t, want, members)
+}
@@ -207,3 +207,34 @@ func (s *ProjectSnippetsService) SnippetContent(pid interface{}, snippet int, op
 
 	return b.Bytes(), resp, err
 }
+
+// SnippetRepositoryFile returns the raw content of a file in the repository
+// of a multi-file project snippet, at the given ref.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/project_snippets.html#snippet-repository-file-content
+func (s *ProjectSnippetsService) SnippetRepositoryFile(pid interface{}, snippet int, ref, filename string, options ...RequestOptionFunc) ([]byte, *Response, error) {
+	project, err := parseID(pid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("projects/%s/snippets/%d/files/%s/%s/raw",
+		PathEscape(project),
+		snippet,
+		PathEscape(ref),
+		PathEscape(filename),
+	)
+
+	req, err := s.client.NewRequest(http.MethodGet, u, nil, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var b bytes.Buffer
+	resp, err := s.client.Do(req, &b)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return b.Bytes(), resp, err
+}
@@ -392,3 +392,31 @@ func TestProjectSnippetsService_SnippetContent(t *testing.T) {
 	require.Nil(t, s)
 	require.Equal(t, http.StatusNotFound, resp.StatusCode)
 }
+
+func TestProjectSnippetsService_SnippetRepositoryFile(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/1/snippets/1/files/", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		if r.URL.EscapedPath() != "/api/v4/projects/1/snippets/1/files/main/lib%2Fadd%2Erb/raw" {
+			http.NotFound(w, r)
+			return
+		}
+		fmt.Fprint(w, "def add(a, b)\n  a + b\nend\n")
+	})
+
+	b, resp, err := client.ProjectSnippets.SnippetRepositoryFile(1, 1, "main", "lib/add.rb")
+	require.NoError(t, err)
+	require.NotNil(t, resp)
+	require.Equal(t, []byte("def add(a, b)\n  a + b\nend\n"), b)
+
+	b, resp, err = client.ProjectSnippets.SnippetRepositoryFile(1.01, 1, "main", "lib/add.rb")
+	require.EqualError(t, err, "invalid ID type 1.01, the ID must be an int or a string")
+	require.Nil(t, resp)
+	require.Nil(t, b)
+
+	b, resp, err = client.ProjectSnippets.SnippetRepositoryFile(1, 1, "main", "missing.rb")
+	require.Error(t, err)
+	require.Nil(t, b)
+	require.Equal(t, http.StatusNotFound, resp.StatusCode)
+}
@@ -17,9 +17,11 @@
 package gitlab
 
 import (
+	"errors"
 	"fmt"
 	"net/http"
 	"net/url"
+	"strings"
 )
 
 // ProjectVariablesService handles communication with the
@@ -122,6 +124,47 @@ func (s *ProjectVariablesService) GetVariable(pid interface{}, key string, opt *
 	return v, resp, nil
 }
 
+// maskedValueMinLength is the minimum length of a masked variable value.
+const maskedValueMinLength = 8
+
+// ValidateMaskedValue checks whether value meets the requirements GitLab
+// enforces for masked CI/CD variables: it must be a single line of at least
+// 8 characters, consisting only of alphanumeric characters and the
+// characters @ : . ~ - _ + / =.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/ci/variables/index.html#mask-a-cicd-variable
+func ValidateMaskedValue(value string) error {
+	if len(value) < maskedValueMinLength {
+		return fmt.Errorf("masked variable value must be at least %d characters long", maskedValueMinLength)
+	}
+	if strings.ContainsAny(value, "\r\n") {
+		return errors.New("masked variable value must be a single line")
+	}
+	for _, r := range value {
+		switch {
+		case 'a' <= r && r <= 'z', 'A' <= r && r <= 'Z', '0' <= r && r <= '9':
+		case strings.ContainsRune("@:.~-_+/=", r):
+		default:
+			return fmt.Errorf("masked variable value contains unsupported character %q", r)
+		}
+	}
+	return nil
+}
+
+// validateMaskedOption validates value when the variable is to be masked.
+func validateMaskedOption(value *string, masked ...*bool) error {
+	if value == nil {
+		return nil
+	}
+	for _, m := range masked {
+		if m != nil && *m {
+			return ValidateMaskedValue(*value)
+		}
+	}
+	return nil
+}
+
 // CreateProjectVariableOptions represents the available CreateVariable()
 // options.
 //
@@ -139,7 +182,17 @@ type CreateProjectVariableOptions struct {
 	VariableType     *VariableTypeValue `url:"variable_type,omitempty" json:"variable_type,omitempty"`
 }
 
-// CreateVariable creates a new project variable.
+// validate checks the value of a masked variable before it is sent.
+func (opt *CreateProjectVariableOptions) validate() error {
+	if opt == nil {
+		return nil
+	}
+	return validateMaskedOption(opt.Value, opt.Masked, opt.MaskedAndHidden)
+}
+
+// CreateVariable creates a new project variable. When the variable is
+// masked, its value is checked with ValidateMaskedValue before the request is
+// sent.
 //
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/project_level_variables.html#create-a-variable
@@ -148,6 +201,9 @@ func (s *ProjectVariablesService) CreateVariable(pid interface{}, opt *CreatePro
 	if err != nil {
 		return nil, nil, err
 	}
+	if err := opt.validate(); err != nil {
+		return nil, nil, err
+	}
 	u := fmt.Sprintf("projects/%s/variables", PathEscape(project))
 
 	req, err := s.client.NewRequest(http.MethodPost, u, opt, options)
@@ -180,7 +236,16 @@ type UpdateProjectVariableOptions struct {
 	VariableType     *VariableTypeValue `url:"variable_type,omitempty" json:"variable_type,omitempty"`
 }
 
-// UpdateVariable updates a project's variable.
+// validate checks the value of a masked variable before it is sent.
+func (opt *UpdateProjectVariableOptions) validate() error {
+	if opt == nil {
+		return nil
+	}
+	return validateMaskedOption(opt.Value, opt.Masked)
+}
+
+// UpdateVariable updates a project's variable. When the variable is masked,
+// its value is checked with ValidateMaskedValue before the request is sent.
 //
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/project_level_variables.html#update-a-variable
@@ -189,6 +254,9 @@ func (s *ProjectVariablesService) UpdateVariable(pid interface{}, key string, op
 	if err != nil {
 		return nil, nil, err
 	}
+	if err := opt.validate(); err != nil {
+		return nil, nil, err
+	}
 	u := fmt.Sprintf("projects/%s/variables/%s", PathEscape(project), url.PathEscape(key))
 
 	req, err := s.client.NewRequest(http.MethodPut, u, opt, options)
@@ -232,3 +300,95 @@ func (s *ProjectVariablesService) RemoveVariable(pid interface{}, key string, op
 
 	return s.client.Do(req, nil)
 }
+
+// ProjectVariablesDiff represents the changes needed to reconcile the
+// variables of a project with a desired set of variables.
+type ProjectVariablesDiff struct {
+	Create []*ProjectVariable
+	Update []*ProjectVariable
+	Delete []*ProjectVariable
+}
+
+// DiffProjectVariables compares the variables of a project with the desired
+// set and returns the variables to create, update and delete, without
+// applying any changes. Variables are matched on their key and environment
+// scope, where an empty scope is treated as "*". The value of a hidden
+// variable cannot be read back, so only its settings are compared.
+func (s *ProjectVariablesService) DiffProjectVariables(pid interface{}, desired []*ProjectVariable, options ...RequestOptionFunc) (*ProjectVariablesDiff, *Response, error) {
+	var current []*ProjectVariable
+	var resp *Response
+
+	opt := &ListProjectVariablesOptions{PerPage: 100}
+	for {
+		vs, r, err := s.ListVariables(pid, opt, options...)
+		if err != nil {
+			return nil, r, err
+		}
+		current = append(current, vs...)
+		resp = r
+
+		if r.NextPage == 0 {
+			break
+		}
+		opt.Page = r.NextPage
+	}
+
+	existing := make(map[string]*ProjectVariable, len(current))
+	for _, v := range current {
+		existing[variableDiffKey(v)] = v
+	}
+
+	diff := new(ProjectVariablesDiff)
+	wanted := make(map[string]bool, len(desired))
+	for _, v := range desired {
+		k := variableDiffKey(v)
+		if wanted[k] {
+			return nil, resp, fmt.Errorf("duplicate desired variable %q in environment scope %q", v.Key, v.EnvironmentScope)
+		}
+		wanted[k] = true
+
+		cur, ok := existing[k]
+		switch {
+		case !ok:
+			diff.Create = append(diff.Create, v)
+		case !variableEqual(cur, v):
+			diff.Update = append(diff.Update, v)
+		}
+	}
+
+	for _, v := range current {
+		if !wanted[variableDiffKey(v)] {
+			diff.Delete = append(diff.Delete, v)
+		}
+	}
+
+	return diff, resp, nil
+}
+
+func variableDiffKey(v *ProjectVariable) string {
+	scope := v.EnvironmentScope
+	if scope == "" {
+		scope = "*"
+	}
+	return v.Key + "\x00" + scope
+}
+
+func variableEqual(cur, want *ProjectVariable) bool {
+	if !cur.Hidden && cur.Value != want.Value {
+		return false
+	}
+
+	curType, wantType := cur.VariableType, want.VariableType
+	if curType == "" {
+		curType = EnvVariableType
+	}
+	if wantType == "" {
+		wantType = EnvVariableType
+	}
+
+	return curType == wantType &&
+		cur.Protected == want.Protected &&
+		cur.Masked == want.Masked &&
+		cur.Raw == want.Raw &&
+		cur.Description == want.Description
+}
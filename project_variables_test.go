@@ -210,6 +210,47 @@ func TestProjectVariablesService_CreateVariable_MaskedAndHidden(t *testing.T) {
 	require.Equal(t, http.StatusNotFound, resp.StatusCode)
 }
 
+func TestProjectVariablesService_CreateVariable_InvalidMaskedValue(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/1/variables", func(w http.ResponseWriter, r *http.Request) {
+		t.Error("unexpected request for an invalid masked value")
+	})
+
+	opt := &CreateProjectVariableOptions{
+		Key:    Ptr("TOKEN"),
+		Value:  Ptr("short"),
+		Masked: Ptr(true),
+	}
+	pv, resp, err := client.ProjectVariables.CreateVariable(1, opt)
+	require.EqualError(t, err, "masked variable value must be at least 8 characters long")
+	require.Nil(t, resp)
+	require.Nil(t, pv)
+}
+
+func TestValidateMaskedValue(t *testing.T) {
+	tests := []struct {
+		value string
+		err   string
+	}{
+		{value: "c2VjcmV0LXRva2Vu"},
+		{value: "user@example.com:pass~1_2-3+4/5="},
+		{value: "secret", err: "masked variable value must be at least 8 characters long"},
+		{value: "line one\nline two", err: "masked variable value must be a single line"},
+		{value: "has a space", err: `masked variable value contains unsupported character ' '`},
+		{value: "dollar$sign", err: `masked variable value contains unsupported character '$'`},
+	}
+
+	for _, tt := range tests {
+		err := ValidateMaskedValue(tt.value)
+		if tt.err == "" {
+			require.NoError(t, err, tt.value)
+			continue
+		}
+		require.EqualError(t, err, tt.err, tt.value)
+	}
+}
+
 func TestProjectVariablesService_UpdateVariable(t *testing.T) {
 	mux, client := setup(t)
 
@@ -342,3 +383,41 @@ func TestProjectVariablesService_RemoveVariable(t *testing.T) {
 	require.Error(t, err)
 	require.Equal(t, http.StatusNotFound, resp.StatusCode)
 }
+
+func TestProjectVariablesService_DiffProjectVariables(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/1/variables", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `
+			[
+				{"key": "UNCHANGED", "value": "same", "variable_type": "env_var", "environment_scope": "*"},
+				{"key": "TOKEN", "value": "secret", "variable_type": "env_var", "environment_scope": "production"},
+				{"key": "TOKEN", "value": "secret", "variable_type": "env_var", "environment_scope": "staging"},
+				{"key": "OBSOLETE", "value": "old", "variable_type": "env_var", "environment_scope": "*"}
+			]
+		`)
+	})
+
+	desired := []*ProjectVariable{
+		{Key: "UNCHANGED", Value: "same"},
+		{Key: "TOKEN", Value: "secret", EnvironmentScope: "production", Masked: true, Protected: true},
+		{Key: "TOKEN", Value: "secret", EnvironmentScope: "staging"},
+		{Key: "NEW", Value: "fresh", EnvironmentScope: "*"},
+	}
+
+	diff, resp, err := client.ProjectVariables.DiffProjectVariables(1, desired)
+	require.NoError(t, err)
+	require.NotNil(t, resp)
+
+	require.Equal(t, []*ProjectVariable{desired[3]}, diff.Create)
+	require.Equal(t, []*ProjectVariable{desired[1]}, diff.Update)
+	require.Len(t, diff.Delete, 1)
+	require.Equal(t, "OBSOLETE", diff.Delete[0].Key)
+
+	_, _, err = client.ProjectVariables.DiffProjectVariables(1, []*ProjectVariable{
+		{Key: "DUP"},
+		{Key: "DUP", EnvironmentScope: "*"},
+	})
+	require.EqualError(t, err, `duplicate desired variable "DUP" in environment scope "*"`)
+}
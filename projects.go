@@ -18,6 +18,7 @@ package gitlab
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
@@ -26,6 +27,10 @@ import (
 	"github.com/hashicorp/go-retryablehttp"
 )
 
+// ErrHookTestFailed is returned when a hook target does not accept a test
+// event with a 2xx status code.
+var ErrHookTestFailed = errors.New("hook target did not accept the test event")
+
 // ProjectsService handles communication with the repositories related methods
 // of the GitLab API.
 //
@@ -849,6 +854,7 @@ type EditProjectOptions struct {
 	BuildGitStrategy                          *string                                      `url:"build_git_strategy,omitempty" json:"build_git_strategy,omitempty"`
 	BuildTimeout                              *int                                         `url:"build_timeout,omitempty" json:"build_timeout,omitempty"`
 	BuildsAccessLevel                         *AccessControlValue                          `url:"builds_access_level,omitempty" json:"builds_access_level,omitempty"`
+	CIAllowForkPipelinesToRunInParentProject  *bool                                        `url:"ci_allow_fork_pipelines_to_run_in_parent_project,omitempty" json:"ci_allow_fork_pipelines_to_run_in_parent_project,omitempty"`
 	CIConfigPath                              *string                                      `url:"ci_config_path,omitempty" json:"ci_config_path,omitempty"`
 	CIDefaultGitDepth                         *int                                         `url:"ci_default_git_depth,omitempty" json:"ci_default_git_depth,omitempty"`
 	CIForwardDeploymentEnabled                *bool                                        `url:"ci_forward_deployment_enabled,omitempty" json:"ci_forward_deployment_enabled,omitempty"`
@@ -1168,8 +1174,8 @@ func (s *ProjectsService) UnarchiveProject(pid interface{}, options ...RequestOp
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/projects.html#delete-project
 type DeleteProjectOptions struct {
-	FullPath          *string `url:"full_path" json:"full_path"`
-	PermanentlyRemove *bool   `url:"permanently_remove" json:"permanently_remove"`
+	FullPath          *string `url:"full_path,omitempty" json:"full_path,omitempty"`
+	PermanentlyRemove *bool   `url:"permanently_remove,omitempty" json:"permanently_remove,omitempty"`
 }
 
 // DeleteProject removes a project including all associated resources
@@ -1196,7 +1202,7 @@ func (s *ProjectsService) DeleteProject(pid interface{}, opt *DeleteProjectOptio
 //
 // GitLab API docs: https://docs.gitlab.com/ee/api/projects.html#share-project-with-group
 type ShareWithGroupOptions struct {
-	ExpiresAt   *string           `url:"expires_at" json:"expires_at"`
+	ExpiresAt   *string           `url:"expires_at,omitempty" json:"expires_at,omitempty"`
 	GroupAccess *AccessLevelValue `url:"group_access" json:"group_access"`
 	GroupID     *int              `url:"group_id" json:"group_id"`
 }
@@ -1242,16 +1248,17 @@ func (s *ProjectsService) DeleteSharedProjectFromGroup(pid interface{}, groupID
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/members.html#list-all-members-of-a-group-or-project
 type ProjectMember struct {
-	ID          int              `json:"id"`
-	Username    string           `json:"username"`
-	Email       string           `json:"email"`
-	Name        string           `json:"name"`
-	State       string           `json:"state"`
-	CreatedAt   *time.Time       `json:"created_at"`
-	ExpiresAt   *ISOTime         `json:"expires_at"`
-	AccessLevel AccessLevelValue `json:"access_level"`
-	WebURL      string           `json:"web_url"`
-	AvatarURL   string           `json:"avatar_url"`
+	ID              int              `json:"id"`
+	Username        string           `json:"username"`
+	Email           string           `json:"email"`
+	Name            string           `json:"name"`
+	State           string           `json:"state"`
+	CreatedAt       *time.Time       `json:"created_at"`
+	ExpiresAt       *ISOTime         `json:"expires_at"`
+	AccessLevel     AccessLevelValue `json:"access_level"`
+	WebURL          string           `json:"web_url"`
+	AvatarURL       string           `json:"avatar_url"`
+	MembershipState string           `json:"membership_state"`
 }
 
 // HookCustomHeader represents a project or group hook custom header
@@ -1485,6 +1492,9 @@ func (s *ProjectsService) DeleteProjectHook(pid interface{}, hook int, options .
 // To disable this limit on self-managed GitLab and GitLab Dedicated,
 // an administrator can disable the feature flag named web_hook_test_api_endpoint_rate_limit.
 //
+// The returned error matches ErrHookTestFailed when the hook target does not
+// respond with a 2xx status code.
+//
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/projects.html#trigger-a-test-project-hook
 func (s *ProjectsService) TriggerTestProjectHook(pid interface{}, hook int, event ProjectHookEvent, options ...RequestOptionFunc) (*Response, error) {
@@ -1499,7 +1509,15 @@ func (s *ProjectsService) TriggerTestProjectHook(pid interface{}, hook int, even
 		return nil, err
 	}
 
-	return s.client.Do(req, nil)
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		if resp != nil && resp.StatusCode == http.StatusUnprocessableEntity {
+			return resp, &sentinelError{sentinel: ErrHookTestFailed, err: err}
+		}
+		return resp, err
+	}
+
+	return resp, nil
 }
 
 // SetHookCustomHeaderOptions represents the available SetProjectCustomHeader()
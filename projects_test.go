@@ -436,6 +436,7 @@ func TestGetProjectWithOptions(t *testing.T) {
 
 	mux.HandleFunc("/api/v4/projects/1", func(w http.ResponseWriter, r *http.Request) {
 		testMethod(t, r, http.MethodGet)
+		testParams(t, r, "statistics=true")
 		fmt.Fprint(w, `{
 			"id":1,
 			"statistics": {
@@ -476,6 +477,25 @@ func TestGetProjectWithOptions(t *testing.T) {
 	}
 }
 
+func TestGetProjectLanguages(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/1/languages", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{"Go": 80.52, "Shell": 15.3, "Makefile": 4.18}`)
+	})
+
+	languages, _, err := client.Projects.GetProjectLanguages(1)
+	if err != nil {
+		t.Fatalf("Projects.GetProjectLanguages returns an error: %v", err)
+	}
+
+	want := &ProjectLanguages{"Go": 80.52, "Shell": 15.3, "Makefile": 4.18}
+	if !reflect.DeepEqual(want, languages) {
+		t.Errorf("Projects.GetProjectLanguages returned %+v, want %+v", languages, want)
+	}
+}
+
 func TestCreateProject(t *testing.T) {
 	mux, client := setup(t)
 
@@ -672,6 +692,65 @@ func TestDeleteProject(t *testing.T) {
 	}
 }
 
+func TestDeleteProjectPartialOptions(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodDelete)
+		testParams(t, r, "permanently_remove=true")
+	})
+
+	opt := &DeleteProjectOptions{
+		PermanentlyRemove: Ptr(true),
+	}
+
+	_, err := client.Projects.DeleteProject(1, opt)
+	if err != nil {
+		t.Errorf("Projects.DeleteProject returned error: %v", err)
+	}
+}
+
+func TestEditProjectPartialOptions(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPut)
+		testBody(t, r, `{"name":"renamed"}`)
+		fmt.Fprint(w, `{"id":1,"name":"renamed"}`)
+	})
+
+	project, _, err := client.Projects.EditProject(1, &EditProjectOptions{Name: Ptr("renamed")})
+	if err != nil {
+		t.Fatalf("Projects.EditProject returned error: %v", err)
+	}
+	if project.Name != "renamed" {
+		t.Errorf("Projects.EditProject returned name %q, want %q", project.Name, "renamed")
+	}
+}
+
+func TestEditProjectCICDSettings(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPut)
+		testBody(t, r, `{"build_timeout":3600,"ci_allow_fork_pipelines_to_run_in_parent_project":false,"ci_default_git_depth":20,"keep_latest_artifact":true}`)
+		fmt.Fprint(w, `{"id":1,"build_timeout":3600,"ci_allow_fork_pipelines_to_run_in_parent_project":false,"ci_default_git_depth":20,"keep_latest_artifact":true,"ci_config_path":".gitlab-ci.yml"}`)
+	})
+
+	project, _, err := client.Projects.EditProject(1, &EditProjectOptions{
+		BuildTimeout:                             Ptr(3600),
+		CIAllowForkPipelinesToRunInParentProject: Ptr(false),
+		CIDefaultGitDepth:                        Ptr(20),
+		KeepLatestArtifact:                       Ptr(true),
+	})
+	if err != nil {
+		t.Fatalf("Projects.EditProject returned error: %v", err)
+	}
+	if project.CIDefaultGitDepth != 20 || project.CIConfigPath != ".gitlab-ci.yml" || !project.KeepLatestArtifact {
+		t.Errorf("Projects.EditProject returned %+v", project)
+	}
+}
+
 func TestShareProjectWithGroup(t *testing.T) {
 	mux, client := setup(t)
 
@@ -1496,6 +1575,58 @@ func TestProjectModelsOptionalMergeAttribute(t *testing.T) {
 	assert.False(t, strings.Contains(string(jsonString), "only_allow_merge_if_all_status_checks_passed"))
 }
 
+func TestTriggerTestProjectHook(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/1/hooks/2/test/push_events", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"message":"201 Created"}`)
+	})
+
+	resp, err := client.Projects.TriggerTestProjectHook(1, 2, ProjectHookEventPush)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusCreated, resp.StatusCode)
+}
+
+func TestTriggerTestProjectHookTargetFailed(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/1/hooks/2/test/push_events", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		w.WriteHeader(http.StatusUnprocessableEntity)
+		fmt.Fprint(w, `{"message":"[Hook execution failed: 500 Internal Server Error]"}`)
+	})
+
+	resp, err := client.Projects.TriggerTestProjectHook(1, 2, ProjectHookEventPush)
+	assert.ErrorIs(t, err, ErrHookTestFailed)
+	assert.Equal(t, http.StatusUnprocessableEntity, resp.StatusCode)
+
+	var errResp *ErrorResponse
+	if assert.ErrorAs(t, err, &errResp) {
+		assert.Contains(t, errResp.Message, "500 Internal Server Error")
+	}
+}
+
+func TestAddProjectHookCustomHeaders(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/1/hooks", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testBody(t, r, `{"url":"https://example.com/hook","custom_webhook_template":"{\"event\":\"{{object_kind}}\"}","custom_headers":[{"key":"X-Signature","value":"secret"}]}`)
+		fmt.Fprint(w, `{"id":1,"url":"https://example.com/hook","custom_webhook_template":"{\"event\":\"{{object_kind}}\"}","custom_headers":[{"key":"X-Signature"}]}`)
+	})
+
+	hook, _, err := client.Projects.AddProjectHook(1, &AddProjectHookOptions{
+		URL:                   Ptr("https://example.com/hook"),
+		CustomWebhookTemplate: Ptr(`{"event":"{{object_kind}}"}`),
+		CustomHeaders:         &[]*HookCustomHeader{{Key: "X-Signature", Value: "secret"}},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, `{"event":"{{object_kind}}"}`, hook.CustomWebhookTemplate)
+	assert.Equal(t, []*HookCustomHeader{{Key: "X-Signature"}}, hook.CustomHeaders)
+}
+
 func TestListProjectHooks(t *testing.T) {
 	mux, client := setup(t)
 
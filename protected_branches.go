@@ -17,11 +17,16 @@
 package gitlab
 
 import (
+	"errors"
 	"fmt"
 	"net/http"
 	"net/url"
 )
 
+// ErrInvalidAccessEntry is returned when GitLab rejects an access entry of a
+// protected branch update, for example a user who is not a project member.
+var ErrInvalidAccessEntry = errors.New("invalid protected branch access entry")
+
 // ProtectedBranchesService handles communication with the protected branch
 // related methods of the GitLab API.
 //
@@ -136,7 +141,10 @@ type ProtectRepositoryBranchesOptions struct {
 	CodeOwnerApprovalRequired *bool                       `url:"code_owner_approval_required,omitempty" json:"code_owner_approval_required,omitempty"`
 }
 
-// BranchPermissionOptions represents a branch permission option.
+// BranchPermissionOptions represents a branch permission option. Each entry
+// grants access to a single user, group, deploy key or access level and is
+// sent as one element of the allowed_to_push, allowed_to_merge or
+// allowed_to_unprotect arrays (allowed_to_push[][user_id] and so on).
 //
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/protected_branches.html#protect-repository-branches
@@ -209,7 +217,11 @@ type UpdateProtectedBranchOptions struct {
 	AllowedToUnprotect        *[]*BranchPermissionOptions `url:"allowed_to_unprotect,omitempty" json:"allowed_to_unprotect,omitempty"`
 }
 
-// UpdateProtectedBranch updates a protected branch.
+// UpdateProtectedBranch updates a protected branch. Access entries can be
+// added to AllowedToPush, AllowedToMerge and AllowedToUnprotect, or removed by
+// passing their ID together with Destroy set to true, without unprotecting the
+// branch first. A 422 response for an invalid access entry is returned as
+// ErrInvalidAccessEntry.
 //
 // Gitlab API docs:
 // https://docs.gitlab.com/ee/api/protected_branches.html#update-a-protected-branch
@@ -228,6 +240,9 @@ func (s *ProtectedBranchesService) UpdateProtectedBranch(pid interface{}, branch
 	p := new(ProtectedBranch)
 	resp, err := s.client.Do(req, p)
 	if err != nil {
+		if resp != nil && resp.StatusCode == http.StatusUnprocessableEntity {
+			return nil, resp, &sentinelError{sentinel: ErrInvalidAccessEntry, err: err}
+		}
 		return nil, resp, err
 	}
 
@@ -17,6 +17,7 @@
 package gitlab
 
 import (
+	"errors"
 	"fmt"
 	"net/http"
 	"reflect"
@@ -242,3 +243,135 @@ func TestUpdateRepositoryBranches(t *testing.T) {
 		t.Errorf("ProtectedBranches.UpdateProtectedBranch returned %+v, want %+v", protectedBranch, want)
 	}
 }
+
+func TestProtectRepositoryBranchesAllowedTo(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/1/protected_branches", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testBody(t, r, `{"name":"release/*","allowed_to_push":[{"user_id":5},{"group_id":7}],"allowed_to_merge":[{"group_id":7},{"access_level":40}],"allowed_to_unprotect":[{"deploy_key_id":3}]}`)
+		fmt.Fprint(w, `
+	{
+		"id":1,
+		"name":"release/*",
+		"push_access_levels":[
+			{"id":11,"access_level":40,"user_id":5,"group_id":null,"access_level_description":"Administrator"},
+			{"id":12,"access_level":40,"user_id":null,"group_id":7,"access_level_description":"release-managers"}
+		],
+		"merge_access_levels":[
+			{"id":13,"access_level":40,"user_id":null,"group_id":7,"access_level_description":"release-managers"},
+			{"id":14,"access_level":40,"user_id":null,"group_id":null,"access_level_description":"Maintainers"}
+		],
+		"unprotect_access_levels":[
+			{"id":15,"access_level":40,"deploy_key_id":3,"access_level_description":"Deploy key"}
+		]
+	}`)
+	})
+
+	opt := &ProtectRepositoryBranchesOptions{
+		Name: Ptr("release/*"),
+		AllowedToPush: &[]*BranchPermissionOptions{
+			{UserID: Ptr(5)},
+			{GroupID: Ptr(7)},
+		},
+		AllowedToMerge: &[]*BranchPermissionOptions{
+			{GroupID: Ptr(7)},
+			{AccessLevel: Ptr(MaintainerPermissions)},
+		},
+		AllowedToUnprotect: &[]*BranchPermissionOptions{
+			{DeployKeyID: Ptr(3)},
+		},
+	}
+	branch, _, err := client.ProtectedBranches.ProtectRepositoryBranches("1", opt)
+	if err != nil {
+		t.Fatalf("ProtectedBranches.ProtectRepositoryBranches returned error: %v", err)
+	}
+
+	want := &ProtectedBranch{
+		ID:   1,
+		Name: "release/*",
+		PushAccessLevels: []*BranchAccessDescription{
+			{ID: 11, AccessLevel: 40, UserID: 5, AccessLevelDescription: "Administrator"},
+			{ID: 12, AccessLevel: 40, GroupID: 7, AccessLevelDescription: "release-managers"},
+		},
+		MergeAccessLevels: []*BranchAccessDescription{
+			{ID: 13, AccessLevel: 40, GroupID: 7, AccessLevelDescription: "release-managers"},
+			{ID: 14, AccessLevel: 40, AccessLevelDescription: "Maintainers"},
+		},
+		UnprotectAccessLevels: []*BranchAccessDescription{
+			{ID: 15, AccessLevel: 40, DeployKeyID: 3, AccessLevelDescription: "Deploy key"},
+		},
+	}
+	if !reflect.DeepEqual(want, branch) {
+		t.Errorf("ProtectedBranches.ProtectRepositoryBranches returned %+v, want %+v", branch, want)
+	}
+}
+
+func TestUpdateRepositoryBranchesAllowedToMerge(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/1/protected_branches/main", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPatch)
+		testBody(t, r, `{"allowed_to_merge":[{"user_id":5},{"id":13,"_destroy":true}]}`)
+		fmt.Fprint(w, `{
+			"id": 1,
+			"name": "main",
+			"merge_access_levels": [
+				{"id":14,"access_level":40,"access_level_description":"Maintainers"},
+				{"id":16,"access_level":40,"user_id":5,"access_level_description":"Jane Doe"}
+			]
+		}`)
+	})
+
+	opt := &UpdateProtectedBranchOptions{
+		AllowedToMerge: &[]*BranchPermissionOptions{
+			{UserID: Ptr(5)},
+			{ID: Ptr(13), Destroy: Ptr(true)},
+		},
+	}
+	branch, _, err := client.ProtectedBranches.UpdateProtectedBranch("1", "main", opt)
+	if err != nil {
+		t.Fatalf("ProtectedBranches.UpdateProtectedBranch returned error: %v", err)
+	}
+
+	want := &ProtectedBranch{
+		ID:   1,
+		Name: "main",
+		MergeAccessLevels: []*BranchAccessDescription{
+			{ID: 14, AccessLevel: 40, AccessLevelDescription: "Maintainers"},
+			{ID: 16, AccessLevel: 40, UserID: 5, AccessLevelDescription: "Jane Doe"},
+		},
+	}
+	if !reflect.DeepEqual(want, branch) {
+		t.Errorf("ProtectedBranches.UpdateProtectedBranch returned %+v, want %+v", branch, want)
+	}
+}
+
+func TestUpdateRepositoryBranchesInvalidAccessEntry(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/1/protected_branches/main", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPatch)
+		w.WriteHeader(http.StatusUnprocessableEntity)
+		fmt.Fprint(w, `{"message":{"merge_access_levels.user":["is not a member of the project"]}}`)
+	})
+
+	opt := &UpdateProtectedBranchOptions{
+		AllowedToMerge: &[]*BranchPermissionOptions{{UserID: Ptr(99)}},
+	}
+	_, resp, err := client.ProtectedBranches.UpdateProtectedBranch("1", "main", opt)
+	if err == nil {
+		t.Fatal("ProtectedBranches.UpdateProtectedBranch expected an error")
+	}
+	if resp.StatusCode != http.StatusUnprocessableEntity {
+		t.Errorf("expected status code 422, got %d", resp.StatusCode)
+	}
+	if !errors.Is(err, ErrInvalidAccessEntry) {
+		t.Errorf("expected the error to match ErrInvalidAccessEntry, got %v", err)
+	}
+
+	var errResp *ErrorResponse
+	if !errors.As(err, &errResp) {
+		t.Errorf("expected the error to wrap an *ErrorResponse, got %T", err)
+	}
+}
@@ -49,10 +49,11 @@ type Release struct {
 		AvatarURL string `json:"avatar_url"`
 		WebURL    string `json:"web_url"`
 	} `json:"author"`
-	Commit          Commit `json:"commit"`
-	UpcomingRelease bool   `json:"upcoming_release"`
-	CommitPath      string `json:"commit_path"`
-	TagPath         string `json:"tag_path"`
+	Commit          Commit             `json:"commit"`
+	UpcomingRelease bool               `json:"upcoming_release"`
+	CommitPath      string             `json:"commit_path"`
+	TagPath         string             `json:"tag_path"`
+	Evidences       []*ReleaseEvidence `json:"evidences"`
 	Assets          struct {
 		Count   int `json:"count"`
 		Sources []struct {
@@ -72,6 +73,16 @@ type Release struct {
 	} `json:"_links"`
 }
 
+// ReleaseEvidence represents the evidence collected for a project release.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/user/project/releases/release_evidence.html
+type ReleaseEvidence struct {
+	SHA         string     `json:"sha"`
+	Filepath    string     `json:"filepath"`
+	CollectedAt *time.Time `json:"collected_at"`
+}
+
 // ListReleasesOptions represents ListReleases() options.
 //
 // GitLab API docs:
@@ -219,13 +230,51 @@ func (s *ReleasesService) CreateRelease(pid interface{}, opts *CreateReleaseOpti
 	return r, resp, nil
 }
 
+// GetReleaseEvidence gets the evidence collected for a release. When no
+// evidence has been collected yet, ErrNotFound is returned.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/user/project/releases/release_evidence.html
+func (s *ReleasesService) GetReleaseEvidence(pid interface{}, tagName string, options ...RequestOptionFunc) ([]*ReleaseEvidence, *Response, error) {
+	r, resp, err := s.GetRelease(pid, tagName, options...)
+	if err != nil {
+		return nil, resp, err
+	}
+	if len(r.Evidences) == 0 {
+		return nil, resp, ErrNotFound
+	}
+
+	return r.Evidences, resp, nil
+}
+
+// CreateReleaseEvidence starts collecting evidence for an existing release.
+// The evidence is collected asynchronously and can be retrieved later using
+// GetReleaseEvidence().
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/releases/index.html#collect-release-evidence
+func (s *ReleasesService) CreateReleaseEvidence(pid interface{}, tagName string, options ...RequestOptionFunc) (*Response, error) {
+	project, err := parseID(pid)
+	if err != nil {
+		return nil, err
+	}
+	u := fmt.Sprintf("projects/%s/releases/%s/evidence", PathEscape(project), PathEscape(tagName))
+
+	req, err := s.client.NewRequest(http.MethodPost, u, nil, options)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(req, nil)
+}
+
 // UpdateReleaseOptions represents UpdateRelease() options.
 //
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/releases/index.html#update-a-release
 type UpdateReleaseOptions struct {
-	Name        *string    `url:"name" json:"name"`
-	Description *string    `url:"description" json:"description"`
+	Name        *string    `url:"name,omitempty" json:"name,omitempty"`
+	Description *string    `url:"description,omitempty" json:"description,omitempty"`
 	Milestones  *[]string  `url:"milestones,omitempty" json:"milestones,omitempty"`
 	ReleasedAt  *time.Time `url:"released_at,omitempty" json:"released_at,omitempty"`
 }
@@ -17,9 +17,11 @@
 package gitlab
 
 import (
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
+	"reflect"
 	"strings"
 	"testing"
 	"time"
@@ -294,6 +296,98 @@ func TestReleasesService_CreateReleaseWithReleasedAt(t *testing.T) {
 	}
 }
 
+func TestReleasesService_CreateUpcomingRelease(t *testing.T) {
+	mux, client := setup(t)
+
+	releasedAt := time.Date(2030, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+	mux.HandleFunc("/api/v4/projects/1/releases",
+		func(w http.ResponseWriter, r *http.Request) {
+			testMethod(t, r, http.MethodPost)
+			testBody(t, r, `{"tag_name":"v0.1","released_at":"2030-01-01T00:00:00Z"}`)
+			fmt.Fprint(w, `{"tag_name":"v0.1","released_at":"2030-01-01T00:00:00Z","upcoming_release":true}`)
+		})
+
+	release, _, err := client.Releases.CreateRelease(1, &CreateReleaseOptions{
+		TagName:    Ptr(exampleTagName),
+		ReleasedAt: &releasedAt,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !release.UpcomingRelease {
+		t.Error("expected an upcoming release")
+	}
+	if !release.ReleasedAt.Equal(releasedAt) {
+		t.Errorf("expected released_at %s, got %s", releasedAt, release.ReleasedAt)
+	}
+}
+
+func TestReleasesService_GetReleaseEvidence(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/1/releases/v0.1",
+		func(w http.ResponseWriter, r *http.Request) {
+			testMethod(t, r, http.MethodGet)
+			fmt.Fprint(w, `{
+				"tag_name": "v0.1",
+				"evidences": [{
+					"sha": "760d6cdfb0879c3ffedec13af470e0f71cf52c6cde4d",
+					"filepath": "https://gitlab.example.com/root/awesome-app/-/releases/v0.1/evidence.json",
+					"collected_at": "2019-01-03T01:56:19.539Z"
+				}]
+			}`)
+		})
+
+	evidences, _, err := client.Releases.GetReleaseEvidence(1, exampleTagName)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	collectedAt := time.Date(2019, time.January, 3, 1, 56, 19, 539000000, time.UTC)
+	want := []*ReleaseEvidence{{
+		SHA:         "760d6cdfb0879c3ffedec13af470e0f71cf52c6cde4d",
+		Filepath:    "https://gitlab.example.com/root/awesome-app/-/releases/v0.1/evidence.json",
+		CollectedAt: &collectedAt,
+	}}
+	if !reflect.DeepEqual(want, evidences) {
+		t.Errorf("Releases.GetReleaseEvidence returned %+v, want %+v", evidences, want)
+	}
+}
+
+func TestReleasesService_GetReleaseEvidenceNotCollected(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/1/releases/v0.1",
+		func(w http.ResponseWriter, r *http.Request) {
+			testMethod(t, r, http.MethodGet)
+			fmt.Fprint(w, `{"tag_name":"v0.1","evidences":[]}`)
+		})
+
+	_, _, err := client.Releases.GetReleaseEvidence(1, exampleTagName)
+	if !errors.Is(err, ErrNotFound) {
+		t.Errorf("expected ErrNotFound, got %v", err)
+	}
+}
+
+func TestReleasesService_CreateReleaseEvidence(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/1/releases/v0.1/evidence",
+		func(w http.ResponseWriter, r *http.Request) {
+			testMethod(t, r, http.MethodPost)
+			w.WriteHeader(http.StatusAccepted)
+		})
+
+	resp, err := client.Releases.CreateReleaseEvidence(1, exampleTagName)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.StatusCode != http.StatusAccepted {
+		t.Errorf("expected status %d, got %d", http.StatusAccepted, resp.StatusCode)
+	}
+}
+
 func TestReleasesService_UpdateRelease(t *testing.T) {
 	mux, client := setup(t)
 
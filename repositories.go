@@ -18,6 +18,7 @@ package gitlab
 
 import (
 	"bytes"
+	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
@@ -252,6 +253,99 @@ func (s *RepositoriesService) Compare(pid interface{}, opt *CompareOptions, opti
 	return c, resp, nil
 }
 
+// CompareStream compares branches, tags or commits like Compare, but decodes
+// the diffs one at a time while reading the response and passes each of them
+// to fn, so large comparisons do not have to be held in memory at once. The
+// returned Compare holds all other fields; its Diffs are left empty. GitLab
+// does not paginate the diffs of a comparison, so callers should check
+// CompareTimeout to detect a truncated result.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/repositories.html#compare-branches-tags-or-commits
+func (s *RepositoriesService) CompareStream(pid interface{}, opt *CompareOptions, fn func(*Diff) error, options ...RequestOptionFunc) (*Compare, *Response, error) {
+	project, err := parseID(pid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("projects/%s/repository/compare", PathEscape(project))
+
+	req, err := s.client.NewRequest(http.MethodGet, u, opt, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	stream := new(responseStream)
+	resp, err := s.client.Do(req, stream)
+	if err != nil {
+		return nil, resp, err
+	}
+	defer stream.Close()
+
+	c, err := decodeCompareStream(json.NewDecoder(stream), fn)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return c, resp, nil
+}
+
+// decodeCompareStream decodes a compare response, passing every element of
+// its diffs array to fn instead of collecting them.
+func decodeCompareStream(dec *json.Decoder, fn func(*Diff) error) (*Compare, error) {
+	if _, err := dec.Token(); err != nil {
+		return nil, err
+	}
+
+	fields := make(map[string]json.RawMessage)
+	for dec.More() {
+		t, err := dec.Token()
+		if err != nil {
+			return nil, err
+		}
+		key, _ := t.(string)
+
+		if key != "diffs" {
+			var raw json.RawMessage
+			if err := dec.Decode(&raw); err != nil {
+				return nil, err
+			}
+			fields[key] = raw
+			continue
+		}
+
+		t, err = dec.Token()
+		if err != nil {
+			return nil, err
+		}
+		if t == nil {
+			continue
+		}
+		for dec.More() {
+			d := new(Diff)
+			if err := dec.Decode(d); err != nil {
+				return nil, err
+			}
+			if err := fn(d); err != nil {
+				return nil, err
+			}
+		}
+		if _, err := dec.Token(); err != nil {
+			return nil, err
+		}
+	}
+
+	data, err := json.Marshal(fields)
+	if err != nil {
+		return nil, err
+	}
+	c := new(Compare)
+	if err := json.Unmarshal(data, c); err != nil {
+		return nil, err
+	}
+
+	return c, nil
+}
+
 // Contributor represents a GitLap contributor.
 //
 // GitLab API docs: https://docs.gitlab.com/ee/api/repositories.html#contributors
@@ -1,6 +1,8 @@
 package gitlab
 
 import (
+	"bytes"
+	"errors"
 	"fmt"
 	"net/http"
 	"testing"
@@ -212,6 +214,25 @@ func TestRepositoriesService_StreamArchive(t *testing.T) {
 	require.Equal(t, http.StatusNotFound, resp.StatusCode)
 }
 
+func TestRepositoriesService_StreamArchiveFormat(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/1/repository/archive.tar.bz2", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testParams(t, r, "path=docs&sha=main")
+		w.Header().Set("Content-Disposition", `attachment; filename="project-main-docs.tar.bz2"`)
+		fmt.Fprint(w, "archive-content")
+	})
+
+	var buf bytes.Buffer
+	opt := &ArchiveOptions{Format: Ptr("tar.bz2"), SHA: Ptr("main"), Path: Ptr("docs")}
+
+	resp, err := client.Repositories.StreamArchive(1, &buf, opt)
+	require.NoError(t, err)
+	require.Equal(t, "archive-content", buf.String())
+	require.Equal(t, `attachment; filename="project-main-docs.tar.bz2"`, resp.Header.Get("Content-Disposition"))
+}
+
 func TestRepositoriesService_Compare(t *testing.T) {
 	mux, client := setup(t)
 
@@ -305,6 +326,53 @@ func TestRepositoriesService_Compare(t *testing.T) {
 	require.Equal(t, http.StatusNotFound, resp.StatusCode)
 }
 
+func TestRepositoriesService_CompareStream(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/1/repository/compare", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testParams(t, r, "from=main&straight=true&to=feature&unidiff=true")
+		fmt.Fprint(w, `{
+			"commit": {"id": "b"},
+			"commits": [{"id": "a"}, {"id": "b"}],
+			"diffs": [
+				{"old_path": "a.txt", "new_path": "a.txt", "diff": "@@ -1 +1 @@"},
+				{"old_path": "b.txt", "new_path": "c.txt", "renamed_file": true}
+			],
+			"compare_timeout": true,
+			"compare_same_ref": false,
+			"web_url": "https://gitlab.example.com/compare/main...feature"
+		}`)
+	})
+
+	opt := &CompareOptions{
+		From:     Ptr("main"),
+		To:       Ptr("feature"),
+		Straight: Ptr(true),
+		Unidiff:  Ptr(true),
+	}
+
+	var paths []string
+	c, _, err := client.Repositories.CompareStream(1, opt, func(d *Diff) error {
+		paths = append(paths, d.NewPath)
+		return nil
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"a.txt", "c.txt"}, paths)
+	assert.Empty(t, c.Diffs)
+	assert.Len(t, c.Commits, 2)
+	assert.Equal(t, "b", c.Commit.ID)
+	assert.True(t, c.CompareTimeout)
+	assert.Equal(t, "https://gitlab.example.com/compare/main...feature", c.WebURL)
+
+	stop := errors.New("stop")
+	_, _, err = client.Repositories.CompareStream(1, opt, func(d *Diff) error {
+		return stop
+	})
+	assert.Equal(t, stop, err)
+}
+
 func TestRepositoriesService_Contributors(t *testing.T) {
 	mux, client := setup(t)
 
@@ -18,9 +18,13 @@ package gitlab
 
 import (
 	"bytes"
+	"errors"
 	"fmt"
+	"mime"
 	"net/http"
+	"path"
 	"strconv"
+	"sync"
 	"time"
 )
 
@@ -47,6 +51,11 @@ type File struct {
 	CommitID        string `json:"commit_id"`
 	SHA256          string `json:"content_sha256"`
 	LastCommitID    string `json:"last_commit_id"`
+
+	// MIMEType is set by GetFileMetaData() from the Content-Type returned by
+	// GitLab, or detected from the file name when GitLab only reports JSON.
+	// It is empty when the type is unknown.
+	MIMEType string `json:"-"`
 }
 
 func (r File) String() string {
@@ -136,6 +145,7 @@ func (s *RepositoryFilesService) GetFileMetaData(pid interface{}, fileName strin
 		SHA256:          resp.Header.Get("X-Gitlab-Content-Sha256"),
 		LastCommitID:    resp.Header.Get("X-Gitlab-Last-Commit-Id"),
 	}
+	f.MIMEType = fileMIMEType(resp.Header.Get("Content-Type"), f.FileName)
 
 	if sizeString := resp.Header.Get("X-Gitlab-Size"); sizeString != "" {
 		f.Size, err = strconv.Atoi(sizeString)
@@ -147,6 +157,64 @@ func (s *RepositoryFilesService) GetFileMetaData(pid interface{}, fileName strin
 	return f, resp, nil
 }
 
+// fileMIMEType prefers the Content-Type of a blob response and falls back to
+// the extension of the file name when GitLab reports the JSON API type.
+func fileMIMEType(contentType, fileName string) string {
+	if mediaType, _, err := mime.ParseMediaType(contentType); err == nil && mediaType != "application/json" {
+		return contentType
+	}
+	return mime.TypeByExtension(path.Ext(fileName))
+}
+
+// FileExists checks whether a file exists in the repository at the given ref,
+// using a HEAD request so the file content is not transferred.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/repository_files.html#get-file-from-repository
+func (s *RepositoryFilesService) FileExists(pid interface{}, fileName, ref string, options ...RequestOptionFunc) (bool, *Response, error) {
+	_, resp, err := s.GetFileMetaData(pid, fileName, &GetFileMetaDataOptions{Ref: Ptr(ref)}, options...)
+	if err != nil {
+		if errors.Is(err, ErrNotFound) {
+			return false, resp, nil
+		}
+		return false, resp, err
+	}
+
+	return true, resp, nil
+}
+
+// batchFileWorkers bounds the number of files fetched concurrently by
+// GetFilesBatch.
+const batchFileWorkers = 4
+
+// GetFilesBatch fetches several files from the repository at the given ref
+// concurrently. Files that could not be fetched, for example because they do
+// not exist, are left out of the returned files and their error is returned
+// instead, so a single failure does not fail the whole batch.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/repository_files.html#get-file-from-repository
+func (s *RepositoryFilesService) GetFilesBatch(pid interface{}, fileNames []string, ref string, options ...RequestOptionFunc) (map[string]*File, map[string]error) {
+	files := make(map[string]*File, len(fileNames))
+	errs := make(map[string]error)
+
+	var mu sync.Mutex
+	forEachBounded(len(fileNames), batchFileWorkers, func(i int) {
+		name := fileNames[i]
+		f, _, err := s.GetFile(pid, name, &GetFileOptions{Ref: Ptr(ref)}, options...)
+
+		mu.Lock()
+		defer mu.Unlock()
+		if err != nil {
+			errs[name] = err
+			return
+		}
+		files[name] = f
+	})
+
+	return files, errs
+}
+
 // FileBlameRange represents one item of blame information.
 //
 // GitLab API docs: https://docs.gitlab.com/ee/api/repository_files.html
@@ -81,6 +81,7 @@ func TestRepositoryFilesService_GetFileMetaData(t *testing.T) {
 		w.Header().Set("X-Gitlab-Last-Commit-Id", "570e7b2abdd848b95f2f578043fc23bd6f6fd24d")
 		w.Header().Set("X-Gitlab-Ref", "master")
 		w.Header().Set("X-Gitlab-Size", "1476")
+		w.Header().Set("Content-Type", "text/x-ruby")
 	})
 
 	want := &File{
@@ -94,6 +95,7 @@ func TestRepositoryFilesService_GetFileMetaData(t *testing.T) {
 		CommitID:        "d5a3ff139356ce33e37e73add446f16869741b50",
 		SHA256:          "4c294617b60715c1d218e61164a3abd4808a4284cbc30e6728a01ad9aada4481",
 		LastCommitID:    "570e7b2abdd848b95f2f578043fc23bd6f6fd24d",
+		MIMEType:        "text/x-ruby",
 	}
 
 	f, resp, err := client.RepositoryFiles.GetFileMetaData(13083, "app%2Fmodels%2Fkey%2Erb?ref=master", nil)
@@ -117,6 +119,69 @@ func TestRepositoryFilesService_GetFileMetaData(t *testing.T) {
 	require.Equal(t, http.StatusNotFound, resp.StatusCode)
 }
 
+func TestRepositoryFilesService_GetFileMetaDataBinary(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/13083/repository/files/assets/logo.PNG", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodHead)
+		testParams(t, r, "ref=main")
+		w.Header().Set("X-Gitlab-Blob-Id", "b2a5c7a4ac0c8d5ab7b7cb5d1e9b1d6d1f7b2c1e")
+		w.Header().Set("X-Gitlab-Commit-Id", "d5a3ff139356ce33e37e73add446f16869741b50")
+		w.Header().Set("X-Gitlab-Content-Sha256", "2c26b46b68ffc68ff99b453c1d30413413422d706483bfa0f98a5e886266e7ae")
+		w.Header().Set("X-Gitlab-Encoding", "base64")
+		w.Header().Set("X-Gitlab-File-Name", "logo.PNG")
+		w.Header().Set("X-Gitlab-File-Path", "assets/logo.PNG")
+		w.Header().Set("X-Gitlab-Last-Commit-Id", "570e7b2abdd848b95f2f578043fc23bd6f6fd24d")
+		w.Header().Set("X-Gitlab-Ref", "main")
+		w.Header().Set("X-Gitlab-Size", "1048576")
+	})
+
+	want := &File{
+		FileName:     "logo.PNG",
+		FilePath:     "assets/logo.PNG",
+		Size:         1048576,
+		Encoding:     "base64",
+		Ref:          "main",
+		BlobID:       "b2a5c7a4ac0c8d5ab7b7cb5d1e9b1d6d1f7b2c1e",
+		CommitID:     "d5a3ff139356ce33e37e73add446f16869741b50",
+		SHA256:       "2c26b46b68ffc68ff99b453c1d30413413422d706483bfa0f98a5e886266e7ae",
+		LastCommitID: "570e7b2abdd848b95f2f578043fc23bd6f6fd24d",
+		MIMEType:     "image/png",
+	}
+
+	f, _, err := client.RepositoryFiles.GetFileMetaData(13083, "assets/logo.PNG", &GetFileMetaDataOptions{Ref: Ptr("main")})
+	require.NoError(t, err)
+	require.Equal(t, want, f)
+
+	f, resp, err := client.RepositoryFiles.GetFileMetaData(13083, "assets/missing.png", &GetFileMetaDataOptions{Ref: Ptr("main")})
+	require.ErrorIs(t, err, ErrNotFound)
+	require.Nil(t, f)
+	require.Equal(t, http.StatusNotFound, resp.StatusCode)
+}
+
+func TestRepositoryFilesService_GetFileMetaDataContentType(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/13083/repository/files/docs/manual", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodHead)
+		w.Header().Set("Content-Type", "application/pdf")
+		w.Header().Set("X-Gitlab-File-Name", "manual")
+	})
+	mux.HandleFunc("/api/v4/projects/13083/repository/files/docs/notes.xml", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodHead)
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("X-Gitlab-File-Name", "notes.xml")
+	})
+
+	f, _, err := client.RepositoryFiles.GetFileMetaData(13083, "docs/manual", nil)
+	require.NoError(t, err)
+	require.Equal(t, "application/pdf", f.MIMEType)
+
+	f, _, err = client.RepositoryFiles.GetFileMetaData(13083, "docs/notes.xml", nil)
+	require.NoError(t, err)
+	require.Equal(t, "text/xml; charset=utf-8", f.MIMEType)
+}
+
 func TestRepositoryFilesService_GetFileBlame(t *testing.T) {
 	mux, client := setup(t)
 
@@ -356,3 +421,44 @@ func TestRepositoryFilesService_DeleteFile(t *testing.T) {
 	require.Error(t, err)
 	require.Equal(t, http.StatusNotFound, resp.StatusCode)
 }
+
+func TestRepositoryFilesService_FileExists(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/1/repository/files/README.md", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodHead)
+		testParams(t, r, "ref=main")
+		w.Header().Set("X-Gitlab-File-Name", "README.md")
+	})
+
+	exists, _, err := client.RepositoryFiles.FileExists(1, "README.md", "main")
+	require.NoError(t, err)
+	require.True(t, exists)
+
+	exists, resp, err := client.RepositoryFiles.FileExists(1, "missing.md", "main")
+	require.NoError(t, err)
+	require.False(t, exists)
+	require.Equal(t, http.StatusNotFound, resp.StatusCode)
+}
+
+func TestRepositoryFilesService_GetFilesBatch(t *testing.T) {
+	mux, client := setup(t)
+
+	for _, name := range []string{".gitlab-ci.yml", "config/app.yml"} {
+		name := name
+		mux.HandleFunc("/api/v4/projects/1/repository/files/"+name, func(w http.ResponseWriter, r *http.Request) {
+			testMethod(t, r, http.MethodGet)
+			testParams(t, r, "ref=main")
+			fmt.Fprintf(w, `{"file_name":%q,"file_path":%q,"ref":"main"}`, name, name)
+		})
+	}
+
+	files, errs := client.RepositoryFiles.GetFilesBatch(1, []string{".gitlab-ci.yml", "config/app.yml", "missing.yml"}, "main")
+
+	require.Len(t, files, 2)
+	require.Equal(t, ".gitlab-ci.yml", files[".gitlab-ci.yml"].FilePath)
+	require.Equal(t, "config/app.yml", files["config/app.yml"].FilePath)
+
+	require.Len(t, errs, 1)
+	require.ErrorIs(t, errs["missing.yml"], ErrNotFound)
+}
@@ -19,6 +19,7 @@ package gitlab
 import (
 	"context"
 	"net/url"
+	"time"
 
 	retryablehttp "github.com/hashicorp/go-retryablehttp"
 )
@@ -34,6 +35,16 @@ func WithContext(ctx context.Context) RequestOptionFunc {
 	}
 }
 
+// WithCorrelationID sets the correlation ID of the request, so it can be
+// traced through proxies and GitLab's logs. The ID is sent in the X-Request-Id
+// header, or in the header configured with WithCorrelationIDHeader.
+func WithCorrelationID(id string) RequestOptionFunc {
+	return func(req *retryablehttp.Request) error {
+		req.Header.Set(xRequestID, id)
+		return nil
+	}
+}
+
 // WithHeader takes a header name and value and appends it to the request headers.
 func WithHeader(name, value string) RequestOptionFunc {
 	return func(req *retryablehttp.Request) error {
@@ -74,6 +85,37 @@ func WithKeysetPaginationParameters(nextLink string) RequestOptionFunc {
 	}
 }
 
+// requestCancelKey is the context key used to store the cancel function of a
+// context derived by WithRequestTimeout, so Client.Do can release it.
+type requestCancelKey struct{}
+
+// WithRequestTimeout makes a single request fail after the given duration,
+// without having to pass a context. The timeout is derived from the current
+// context of the request, so when combined with WithContext the sooner of both
+// deadlines wins, as long as WithRequestTimeout is passed after WithContext.
+// The derived context is canceled as soon as Client.Do returns.
+//
+// Note that the timeout covers the complete call, including all retries and
+// the backoff in between them. So when retries are enabled, a timeout shorter
+// than the combined retry wait time will stop retrying early.
+func WithRequestTimeout(d time.Duration) RequestOptionFunc {
+	return func(req *retryablehttp.Request) error {
+		prev, _ := req.Context().Value(requestCancelKey{}).(context.CancelFunc)
+
+		ctx, cancel := context.WithTimeout(req.Context(), d)
+		if prev != nil {
+			timeoutCancel := cancel
+			cancel = func() {
+				timeoutCancel()
+				prev()
+			}
+		}
+
+		*req = *req.WithContext(context.WithValue(ctx, requestCancelKey{}, cancel))
+		return nil
+	}
+}
+
 // WithSudo takes either a username or user ID and sets the SUDO request header.
 func WithSudo(uid interface{}) RequestOptionFunc {
 	return func(req *retryablehttp.Request) error {
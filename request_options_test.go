@@ -14,6 +14,7 @@
 package gitlab
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"testing"
@@ -23,6 +24,43 @@ import (
 	"github.com/stretchr/testify/assert"
 )
 
+func TestWithCorrelationID(t *testing.T) {
+	mux, client := setup(t)
+	mux.HandleFunc("/api/v4/user", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "my-trace-id", r.Header.Get("X-Request-Id"))
+		w.Header().Set("X-Request-Id", "01HXYZ")
+		fmt.Fprint(w, `{"id":1}`)
+	})
+
+	_, resp, err := client.Users.CurrentUser(WithCorrelationID("my-trace-id"))
+	assert.NoError(t, err)
+	assert.Equal(t, "01HXYZ", resp.CorrelationID)
+}
+
+func TestWithCorrelationIDHeader(t *testing.T) {
+	mux, client := setup(t)
+	mux.HandleFunc("/api/v4/user", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "my-trace-id", r.Header.Get("X-Correlation-Id"))
+		assert.Empty(t, r.Header.Get("X-Request-Id"))
+		fmt.Fprint(w, `{"id":1}`)
+	})
+
+	client, err := NewClient("",
+		WithBaseURL(client.BaseURL().String()),
+		WithUserAgent("my-tool/1.0"),
+		WithCorrelationIDHeader("X-Correlation-Id"),
+	)
+	assert.NoError(t, err)
+
+	req, err := client.NewRequest(http.MethodGet, "user", nil, []RequestOptionFunc{WithCorrelationID("my-trace-id")})
+	assert.NoError(t, err)
+	assert.Equal(t, "my-tool/1.0", req.Header.Get("User-Agent"))
+
+	resp, err := client.Do(req, nil)
+	assert.NoError(t, err)
+	assert.Empty(t, resp.CorrelationID)
+}
+
 func TestWithHeader(t *testing.T) {
 	mux, client := setup(t)
 	mux.HandleFunc("/api/v4/without-header", func(w http.ResponseWriter, r *http.Request) {
@@ -182,3 +220,64 @@ func TestWithKeysetPaginationParameters(t *testing.T) {
 	// Ensure cursor gets properly pulled from "next link" header
 	assert.Equal(t, "eyJuYW1lIjoiRmxpZ2h0anMiLCJpZCI6IjI2IiwiX2tkIjoibiJ9", values.Get("cursor"))
 }
+
+func TestWithRequestTimeout(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/slow", func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-r.Context().Done():
+		case <-time.After(5 * time.Second):
+		}
+	})
+	mux.HandleFunc("/api/v4/fast", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+
+	req, err := client.NewRequest(http.MethodGet, "slow", nil, []RequestOptionFunc{WithRequestTimeout(50 * time.Millisecond)})
+	assert.NoError(t, err)
+
+	start := time.Now()
+	_, err = client.Do(req, nil)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Less(t, time.Since(start), 5*time.Second)
+
+	// The derived context must be canceled once the call returns.
+	req, err = client.NewRequest(http.MethodGet, "fast", nil, []RequestOptionFunc{WithRequestTimeout(time.Hour)})
+	assert.NoError(t, err)
+
+	_, err = client.Do(req, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, context.Canceled, req.Context().Err())
+}
+
+func TestWithRequestTimeoutSoonerDeadline(t *testing.T) {
+	_, client := setup(t)
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	parentDeadline, _ := ctx.Deadline()
+
+	// The context deadline is sooner than the request timeout.
+	req, err := client.NewRequest(http.MethodGet, "fast", nil, []RequestOptionFunc{
+		WithContext(ctx),
+		WithRequestTimeout(time.Hour),
+	})
+	assert.NoError(t, err)
+
+	deadline, ok := req.Context().Deadline()
+	assert.True(t, ok)
+	assert.Equal(t, parentDeadline, deadline)
+
+	// The request timeout is sooner than the context deadline.
+	req, err = client.NewRequest(http.MethodGet, "fast", nil, []RequestOptionFunc{
+		WithContext(ctx),
+		WithRequestTimeout(time.Second),
+	})
+	assert.NoError(t, err)
+
+	deadline, ok = req.Context().Deadline()
+	assert.True(t, ok)
+	assert.True(t, deadline.Before(parentDeadline))
+}
@@ -17,6 +17,7 @@
 package gitlab
 
 import (
+	"errors"
 	"fmt"
 	"net/http"
 	"time"
@@ -442,6 +443,41 @@ func (s *RunnersService) RegisterNewRunner(opt *RegisterNewRunnerOptions, option
 	return r, resp, nil
 }
 
+// validate checks that the runner type is known and that group and project
+// runners name the group or project they belong to.
+func (opt *CreateUserRunnerOptions) validate() error {
+	if opt == nil || opt.RunnerType == nil {
+		return errors.New("runner type is required")
+	}
+	switch *opt.RunnerType {
+	case "instance_type":
+	case "group_type":
+		if opt.GroupID == nil {
+			return errors.New("group ID is required for a group_type runner")
+		}
+	case "project_type":
+		if opt.ProjectID == nil {
+			return errors.New("project ID is required for a project_type runner")
+		}
+	default:
+		return fmt.Errorf("unknown runner type %q", *opt.RunnerType)
+	}
+	return nil
+}
+
+// CreateRunner creates a runner using the runner creation workflow. It
+// validates the runner type and its group or project ID, and then delegates to
+// UsersService.CreateUserRunner.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/users.html#create-a-runner
+func (s *RunnersService) CreateRunner(opt *CreateUserRunnerOptions, options ...RequestOptionFunc) (*UserRunner, *Response, error) {
+	if err := opt.validate(); err != nil {
+		return nil, nil, err
+	}
+	return s.client.Users.CreateUserRunner(opt, options...)
+}
+
 // DeleteRegisteredRunnerOptions represents the available
 // DeleteRegisteredRunner() options.
 //
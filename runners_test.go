@@ -22,6 +22,8 @@ import (
 	"reflect"
 	"testing"
 	"time"
+
+	"github.com/stretchr/testify/require"
 )
 
 func TestDisableRunner(t *testing.T) {
@@ -514,3 +516,46 @@ func TestResetRunnerAuthenticationToken(t *testing.T) {
 		t.Errorf("Runners.ResetRunnerAuthenticationToken returned returned status code  %+v, want %+v", resp.StatusCode, wantCode)
 	}
 }
+
+func TestCreateRunner(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/user/runners", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testBody(t, r, `{"runner_type":"group_type","group_id":5,"description":"ci","paused":true,"tag_list":["docker"],"maximum_timeout":3600}`)
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{
+			"id": 9171,
+			"token": "glrt-kyahzxLaj4Dc1jQf4xjX",
+			"token_expires_at": null
+		}`)
+	})
+
+	runner, resp, err := client.Runners.CreateRunner(&CreateUserRunnerOptions{
+		RunnerType:     Ptr("group_type"),
+		GroupID:        Ptr(5),
+		Description:    Ptr("ci"),
+		Paused:         Ptr(true),
+		TagList:        &[]string{"docker"},
+		MaximumTimeout: Ptr(3600),
+	})
+	require.NoError(t, err)
+	require.Equal(t, http.StatusCreated, resp.StatusCode)
+	require.Equal(t, &UserRunner{ID: 9171, Token: "glrt-kyahzxLaj4Dc1jQf4xjX"}, runner)
+}
+
+func TestCreateRunnerValidation(t *testing.T) {
+	_, client := setup(t)
+
+	tests := []*CreateUserRunnerOptions{
+		nil,
+		{RunnerType: Ptr("shared")},
+		{RunnerType: Ptr("group_type")},
+		{RunnerType: Ptr("project_type"), GroupID: Ptr(5)},
+	}
+	for _, opt := range tests {
+		_, resp, err := client.Runners.CreateRunner(opt)
+		require.Error(t, err)
+		require.Nil(t, resp)
+	}
+}
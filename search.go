@@ -43,6 +43,14 @@ type searchOptions struct {
 	Search string `url:"search" json:"search"`
 }
 
+func newSearchOptions(scope, query string, opt *SearchOptions) *searchOptions {
+	opts := &searchOptions{Scope: scope, Search: query}
+	if opt != nil {
+		opts.SearchOptions = *opt
+	}
+	return opts
+}
+
 // Projects searches the expression within projects
 //
 // GitLab API docs: https://docs.gitlab.com/ee/api/search.html#scope-projects
@@ -313,8 +321,28 @@ func (s *SearchService) UsersByProject(pid interface{}, query string, opt *Searc
 	return ret, resp, err
 }
 
+// GroupSearchBy searches the expression within the given scope for the
+// specified group. The results are decoded into result, which should be a
+// pointer to a slice of the type matching the scope (for example *[]*Blob
+// for SearchScopeBlobs).
+//
+// GitLab API docs: https://docs.gitlab.com/ee/api/search.html#group-search-api
+func (s *SearchService) GroupSearchBy(gid interface{}, scope SearchScopeValue, query string, result interface{}, opt *SearchOptions, options ...RequestOptionFunc) (*Response, error) {
+	return s.searchByGroup(gid, string(scope), query, result, opt, options...)
+}
+
+// ProjectSearchBy searches the expression within the given scope for the
+// specified project. The results are decoded into result, which should be a
+// pointer to a slice of the type matching the scope (for example *[]*Commit
+// for SearchScopeCommits).
+//
+// GitLab API docs: https://docs.gitlab.com/ee/api/search.html#project-search-api
+func (s *SearchService) ProjectSearchBy(pid interface{}, scope SearchScopeValue, query string, result interface{}, opt *SearchOptions, options ...RequestOptionFunc) (*Response, error) {
+	return s.searchByProject(pid, string(scope), query, result, opt, options...)
+}
+
 func (s *SearchService) search(scope, query string, result interface{}, opt *SearchOptions, options ...RequestOptionFunc) (*Response, error) {
-	opts := &searchOptions{SearchOptions: *opt, Scope: scope, Search: query}
+	opts := newSearchOptions(scope, query, opt)
 
 	req, err := s.client.NewRequest(http.MethodGet, "search", opts, options)
 	if err != nil {
@@ -331,7 +359,7 @@ func (s *SearchService) searchByGroup(gid interface{}, scope, query string, resu
 	}
 	u := fmt.Sprintf("groups/%s/-/search", PathEscape(group))
 
-	opts := &searchOptions{SearchOptions: *opt, Scope: scope, Search: query}
+	opts := newSearchOptions(scope, query, opt)
 
 	req, err := s.client.NewRequest(http.MethodGet, u, opts, options)
 	if err != nil {
@@ -348,7 +376,7 @@ func (s *SearchService) searchByProject(pid interface{}, scope, query string, re
 	}
 	u := fmt.Sprintf("projects/%s/-/search", PathEscape(project))
 
-	opts := &searchOptions{SearchOptions: *opt, Scope: scope, Search: query}
+	opts := newSearchOptions(scope, query, opt)
 
 	req, err := s.client.NewRequest(http.MethodGet, u, opts, options)
 	if err != nil {
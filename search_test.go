@@ -17,6 +17,7 @@
 package gitlab
 
 import (
+	"fmt"
 	"net/http"
 	"testing"
 
@@ -94,3 +95,59 @@ func TestSearchService_UsersByProject(t *testing.T) {
 	}}
 	require.Equal(t, want, users)
 }
+
+func TestSearchService_ProjectSearchBy(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/6/-/search", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testParams(t, r, "scope=blobs&search=installation")
+		fmt.Fprint(w, `[{
+			"basename": "README",
+			"data": "## Installation\n\nQuick start using the pre-built packages",
+			"path": "README.md",
+			"filename": "README.md",
+			"id": null,
+			"ref": "main",
+			"startline": 46,
+			"project_id": 6
+		}]`)
+	})
+
+	var blobs []*Blob
+	_, err := client.Search.ProjectSearchBy(6, SearchScopeBlobs, "installation", &blobs, nil)
+	require.NoError(t, err)
+
+	want := []*Blob{{
+		Basename:  "README",
+		Data:      "## Installation\n\nQuick start using the pre-built packages",
+		Path:      "README.md",
+		Filename:  "README.md",
+		Ref:       "main",
+		Startline: 46,
+		ProjectID: 6,
+	}}
+	require.Equal(t, want, blobs)
+}
+
+func TestSearchService_GroupSearchBy(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/groups/3/-/search", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testParams(t, r, "scope=commits&search=bye")
+		fmt.Fprint(w, `[{"id":"4109c2d872d5fdb1ed057400d103766aaea97f98","short_id":"4109c2d8","title":"goodbye $.browser","project_id":6}]`)
+	})
+
+	var commits []*Commit
+	_, err := client.Search.GroupSearchBy(3, SearchScopeCommits, "bye", &commits, nil)
+	require.NoError(t, err)
+
+	want := []*Commit{{
+		ID:        "4109c2d872d5fdb1ed057400d103766aaea97f98",
+		ShortID:   "4109c2d8",
+		Title:     "goodbye $.browser",
+		ProjectID: 6,
+	}}
+	require.Equal(t, want, commits)
+}
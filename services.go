@@ -18,12 +18,17 @@ package gitlab
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 	"strconv"
 	"time"
 )
 
+// ErrIntegrationNotFound is matched by the error returned when GitLab does not
+// know an integration slug. The error also matches ErrNotFound.
+var ErrIntegrationNotFound = errors.New("integration not found")
+
 // ServicesService handles communication with the services related methods of
 // the GitLab API.
 //
@@ -87,6 +92,102 @@ func (s *ServicesService) ListServices(pid interface{}, options ...RequestOption
 	return svcs, resp, nil
 }
 
+// Integration represents the settings of any integration, with the integration
+// specific properties decoded into a generic map.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/api/integrations.html
+type Integration struct {
+	Service
+	Properties map[string]interface{} `json:"properties"`
+}
+
+// GetIntegration gets the settings of the integration with the given API slug
+// for a project, for example "slack" or "custom-issue-tracker". The returned
+// error matches ErrIntegrationNotFound for an unknown slug.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/api/integrations.html
+func (s *ServicesService) GetIntegration(pid interface{}, slug string, options ...RequestOptionFunc) (*Integration, *Response, error) {
+	project, err := parseID(pid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("projects/%s/integrations/%s", PathEscape(project), PathEscape(slug))
+
+	req, err := s.client.NewRequest(http.MethodGet, u, nil, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	i := new(Integration)
+	resp, err := s.client.Do(req, i)
+	if err != nil {
+		return nil, resp, integrationError(err)
+	}
+
+	return i, resp, nil
+}
+
+// SetIntegration sets up the integration with the given API slug for a
+// project. The parameters are sent as is, so they must use the names the API
+// documents for the integration. The returned error matches
+// ErrIntegrationNotFound for an unknown slug.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/api/integrations.html
+func (s *ServicesService) SetIntegration(pid interface{}, slug string, params map[string]interface{}, options ...RequestOptionFunc) (*Integration, *Response, error) {
+	project, err := parseID(pid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("projects/%s/integrations/%s", PathEscape(project), PathEscape(slug))
+
+	req, err := s.client.NewRequest(http.MethodPut, u, params, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	i := new(Integration)
+	resp, err := s.client.Do(req, i)
+	if err != nil {
+		return nil, resp, integrationError(err)
+	}
+
+	return i, resp, nil
+}
+
+// DeleteIntegration disables the integration with the given API slug for a
+// project. The returned error matches ErrIntegrationNotFound for an unknown
+// slug.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/api/integrations.html
+func (s *ServicesService) DeleteIntegration(pid interface{}, slug string, options ...RequestOptionFunc) (*Response, error) {
+	project, err := parseID(pid)
+	if err != nil {
+		return nil, err
+	}
+	u := fmt.Sprintf("projects/%s/integrations/%s", PathEscape(project), PathEscape(slug))
+
+	req, err := s.client.NewRequest(http.MethodDelete, u, nil, options)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return resp, integrationError(err)
+	}
+
+	return resp, nil
+}
+
+// integrationError wraps a 404 returned for an integration slug so it matches
+// ErrIntegrationNotFound.
+func integrationError(err error) error {
+	if errors.Is(err, ErrNotFound) {
+		return &sentinelError{sentinel: ErrIntegrationNotFound, err: err}
+	}
+	return err
+}
+
 // CustomIssueTrackerService represents Custom Issue Tracker service settings.
 //
 // GitLab API docs:
@@ -17,6 +17,7 @@
 package gitlab
 
 import (
+	"errors"
 	"fmt"
 	"net/http"
 	"reflect"
@@ -42,6 +43,85 @@ func TestListServices(t *testing.T) {
 	}
 }
 
+func TestGetIntegration(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/1/integrations/mattermost", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{"id":1,"title":"Mattermost notifications","slug":"mattermost","active":true,"properties":{"channel":"dev","notify_only_broken_pipelines":true}}`)
+	})
+	want := &Integration{
+		Service: Service{ID: 1, Title: "Mattermost notifications", Slug: "mattermost", Active: true},
+		Properties: map[string]interface{}{
+			"channel":                      "dev",
+			"notify_only_broken_pipelines": true,
+		},
+	}
+
+	integration, _, err := client.Services.GetIntegration(1, "mattermost")
+	if err != nil {
+		t.Fatalf("Services.GetIntegration returns an error: %v", err)
+	}
+	if !reflect.DeepEqual(want, integration) {
+		t.Errorf("Services.GetIntegration returned %+v, want %+v", integration, want)
+	}
+}
+
+func TestGetIntegrationUnknownSlug(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/1/integrations/unknown", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	_, resp, err := client.Services.GetIntegration(1, "unknown")
+	if !errors.Is(err, ErrIntegrationNotFound) || !errors.Is(err, ErrNotFound) {
+		t.Fatalf("Services.GetIntegration returned error %v, want ErrIntegrationNotFound", err)
+	}
+	if resp.StatusCode != http.StatusNotFound {
+		t.Errorf("Services.GetIntegration returned status code %d, want %d", resp.StatusCode, http.StatusNotFound)
+	}
+}
+
+func TestSetIntegration(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/1/integrations/mattermost", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPut)
+		testBody(t, r, `{"channel":"dev","webhook":"https://mattermost.example.com/hooks/abc"}`)
+		fmt.Fprint(w, `{"id":1,"slug":"mattermost","active":true,"properties":{"channel":"dev"}}`)
+	})
+	want := &Integration{
+		Service:    Service{ID: 1, Slug: "mattermost", Active: true},
+		Properties: map[string]interface{}{"channel": "dev"},
+	}
+
+	integration, _, err := client.Services.SetIntegration(1, "mattermost", map[string]interface{}{
+		"webhook": "https://mattermost.example.com/hooks/abc",
+		"channel": "dev",
+	})
+	if err != nil {
+		t.Fatalf("Services.SetIntegration returns an error: %v", err)
+	}
+	if !reflect.DeepEqual(want, integration) {
+		t.Errorf("Services.SetIntegration returned %+v, want %+v", integration, want)
+	}
+}
+
+func TestDeleteIntegration(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/1/integrations/mattermost", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodDelete)
+	})
+
+	_, err := client.Services.DeleteIntegration(1, "mattermost")
+	if err != nil {
+		t.Fatalf("Services.DeleteIntegration returns an error: %v", err)
+	}
+}
+
 func TestCustomIssueTrackerService(t *testing.T) {
 	mux, client := setup(t)
 
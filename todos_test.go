@@ -43,6 +43,28 @@ func TestListTodos(t *testing.T) {
 	require.Equal(t, want, todos)
 }
 
+func TestListTodosWithFilters(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/todos", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testParams(t, r, "action=assigned&author_id=2&group_id=4&project_id=3&state=pending&type=MergeRequest")
+		w.Write([]byte(`[{"id":1,"state":"pending"}]`))
+	})
+
+	opts := &ListTodosOptions{
+		Action:    Ptr(TodoAssigned),
+		AuthorID:  Ptr(2),
+		ProjectID: Ptr(3),
+		GroupID:   Ptr(4),
+		State:     Ptr("pending"),
+		Type:      Ptr("MergeRequest"),
+	}
+	todos, _, err := client.Todos.ListTodos(opts)
+	require.NoError(t, err)
+	require.Equal(t, []*Todo{{ID: 1, State: "pending"}}, todos)
+}
+
 func TestMarkAllTodosAsDone(t *testing.T) {
 	mux, client := setup(t)
 
@@ -33,6 +33,21 @@ func Ptr[T any](v T) *T {
 	return &v
 }
 
+// AbuseReportActionValue represents a moderation action on an abuse report.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/api/admin/abuse_reports.html
+type AbuseReportActionValue string
+
+// List of available abuse report actions.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/api/admin/abuse_reports.html
+const (
+	BlockUserAbuseReportAction   AbuseReportActionValue = "block_user"
+	BanUserAbuseReportAction     AbuseReportActionValue = "ban_user"
+	DeleteUserAbuseReportAction  AbuseReportActionValue = "delete_user"
+	CloseReportAbuseReportAction AbuseReportActionValue = "close_report"
+)
+
 // AccessControlValue represents an access control value within GitLab,
 // used for managing access to certain project features.
 //
@@ -317,6 +332,64 @@ func DeploymentStatus(v DeploymentStatusValue) *DeploymentStatusValue {
 	return Ptr(v)
 }
 
+// DetailedMergeStatusValue represents the detailed merge status of a merge
+// request.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/merge_requests.html#merge-status
+type DetailedMergeStatusValue string
+
+// List of available detailed merge statuses.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/merge_requests.html#merge-status
+const (
+	DetailedMergeStatusApprovalsSyncing         DetailedMergeStatusValue = "approvals_syncing"
+	DetailedMergeStatusBlockedStatus            DetailedMergeStatusValue = "blocked_status"
+	DetailedMergeStatusBrokenStatus             DetailedMergeStatusValue = "broken_status"
+	DetailedMergeStatusChecking                 DetailedMergeStatusValue = "checking"
+	DetailedMergeStatusCIMustPass               DetailedMergeStatusValue = "ci_must_pass"
+	DetailedMergeStatusCIStillRunning           DetailedMergeStatusValue = "ci_still_running"
+	DetailedMergeStatusCommitsStatus            DetailedMergeStatusValue = "commits_status"
+	DetailedMergeStatusConflict                 DetailedMergeStatusValue = "conflict"
+	DetailedMergeStatusDiscussionsNotResolved   DetailedMergeStatusValue = "discussions_not_resolved"
+	DetailedMergeStatusDraftStatus              DetailedMergeStatusValue = "draft_status"
+	DetailedMergeStatusExternalStatusChecks     DetailedMergeStatusValue = "external_status_checks"
+	DetailedMergeStatusJiraAssociationMissing   DetailedMergeStatusValue = "jira_association_missing"
+	DetailedMergeStatusLockedLFSFiles           DetailedMergeStatusValue = "locked_lfs_files"
+	DetailedMergeStatusLockedPaths              DetailedMergeStatusValue = "locked_paths"
+	DetailedMergeStatusMergeable                DetailedMergeStatusValue = "mergeable"
+	DetailedMergeStatusMergeRequestBlocked      DetailedMergeStatusValue = "merge_request_blocked"
+	DetailedMergeStatusMergeTime                DetailedMergeStatusValue = "merge_time"
+	DetailedMergeStatusNeedRebase               DetailedMergeStatusValue = "need_rebase"
+	DetailedMergeStatusNotApproved              DetailedMergeStatusValue = "not_approved"
+	DetailedMergeStatusNotOpen                  DetailedMergeStatusValue = "not_open"
+	DetailedMergeStatusPoliciesDenied           DetailedMergeStatusValue = "policies_denied"
+	DetailedMergeStatusPreparing                DetailedMergeStatusValue = "preparing"
+	DetailedMergeStatusRequestedChanges         DetailedMergeStatusValue = "requested_changes"
+	DetailedMergeStatusSecurityPolicyViolations DetailedMergeStatusValue = "security_policy_violations"
+	DetailedMergeStatusStatusChecksMustPass     DetailedMergeStatusValue = "status_checks_must_pass"
+	DetailedMergeStatusTitleRegex               DetailedMergeStatusValue = "title_regex"
+	DetailedMergeStatusUnchecked                DetailedMergeStatusValue = "unchecked"
+)
+
+// Blocking reports whether the status prevents the merge request from being
+// merged. Statuses that only mean GitLab has not finished checking the merge
+// request are not blocking. Unknown statuses, for example ones introduced by
+// newer GitLab versions, are treated as blocking.
+func (v DetailedMergeStatusValue) Blocking() bool {
+	switch v {
+	case DetailedMergeStatusMergeable,
+		DetailedMergeStatusApprovalsSyncing,
+		DetailedMergeStatusChecking,
+		DetailedMergeStatusPreparing,
+		DetailedMergeStatusUnchecked:
+		return false
+	default:
+		return true
+	}
+}
+
 // DORAMetricType represents all valid DORA metrics types.
 //
 // GitLab API docs: https://docs.gitlab.com/ee/api/dora/metrics.html
@@ -547,6 +620,19 @@ func (l *LabelOptions) EncodeValues(key string, v *url.Values) error {
 	return nil
 }
 
+// NotFilter represents the negated filters of the issue and merge request
+// list options, which are encoded as not[labels], not[milestone] and so on.
+// It can be combined with the positive filters of the same options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/issues.html#list-issues
+type NotFilter struct {
+	Labels     *LabelOptions `url:"labels,comma,omitempty" json:"labels,omitempty"`
+	Milestone  *string       `url:"milestone,omitempty" json:"milestone,omitempty"`
+	AuthorID   *[]int        `url:"author_id,omitempty" json:"author_id,omitempty"`
+	AssigneeID *[]int        `url:"assignee_id,omitempty" json:"assignee_id,omitempty"`
+}
+
 // LinkTypeValue represents a release link type.
 type LinkTypeValue string
 
@@ -762,6 +848,42 @@ const (
 	NewestFirst ResourceGroupProcessMode = "newest_first"
 )
 
+// ReviewTimelineKindValue represents the kind of an entry in the review
+// timeline of a merge request.
+type ReviewTimelineKindValue string
+
+// List of available review timeline entry kinds.
+const (
+	ReviewTimelineComment         ReviewTimelineKindValue = "comment"
+	ReviewTimelineApproval        ReviewTimelineKindValue = "approval"
+	ReviewTimelineUnapproval      ReviewTimelineKindValue = "unapproval"
+	ReviewTimelineReviewRequested ReviewTimelineKindValue = "review_requested"
+	ReviewTimelineStateChange     ReviewTimelineKindValue = "state_change"
+	ReviewTimelineLabelChange     ReviewTimelineKindValue = "label_change"
+	ReviewTimelineSystemNote      ReviewTimelineKindValue = "system_note"
+)
+
+// SearchScopeValue represents the scope of a search within GitLab.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/api/search.html
+type SearchScopeValue string
+
+// List of available search scopes.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/api/search.html
+const (
+	SearchScopeBlobs         SearchScopeValue = "blobs"
+	SearchScopeCommits       SearchScopeValue = "commits"
+	SearchScopeIssues        SearchScopeValue = "issues"
+	SearchScopeMergeRequests SearchScopeValue = "merge_requests"
+	SearchScopeMilestones    SearchScopeValue = "milestones"
+	SearchScopeNotes         SearchScopeValue = "notes"
+	SearchScopeProjects      SearchScopeValue = "projects"
+	SearchScopeSnippetTitles SearchScopeValue = "snippet_titles"
+	SearchScopeUsers         SearchScopeValue = "users"
+	SearchScopeWikiBlobs     SearchScopeValue = "wiki_blobs"
+)
+
 // SharedRunnersSettingValue determines whether shared runners are enabled for a
 // group’s subgroups and projects.
 //
@@ -841,6 +963,15 @@ type TasksCompletionStatus struct {
 	CompletedCount int `json:"completed_count"`
 }
 
+// Progress returns the fraction of completed tasks, between 0 and 1. It
+// returns 0 when there are no tasks.
+func (t *TasksCompletionStatus) Progress() float64 {
+	if t == nil || t.Count == 0 {
+		return 0
+	}
+	return float64(t.CompletedCount) / float64(t.Count)
+}
+
 // TodoAction represents the available actions that can be performed on a todo.
 //
 // GitLab API docs: https://docs.gitlab.com/ee/api/todos.html
@@ -72,3 +72,38 @@ func TestBoolValue(t *testing.T) {
 		})
 	}
 }
+
+func TestDetailedMergeStatusValueBlocking(t *testing.T) {
+	testCases := []struct {
+		status   DetailedMergeStatusValue
+		blocking bool
+	}{
+		{DetailedMergeStatusMergeable, false},
+		{DetailedMergeStatusChecking, false},
+		{DetailedMergeStatusUnchecked, false},
+		{DetailedMergeStatusCIMustPass, true},
+		{DetailedMergeStatusConflict, true},
+		{DetailedMergeStatusDraftStatus, true},
+		{DetailedMergeStatusNotApproved, true},
+		{DetailedMergeStatusValue("some_future_status"), true},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(string(testCase.status), func(t *testing.T) {
+			if got := testCase.status.Blocking(); got != testCase.blocking {
+				t.Fatalf("Expected Blocking() to be %v but got %v", testCase.blocking, got)
+			}
+		})
+	}
+}
+
+func TestDetailedMergeStatusValueUnmarshalUnknown(t *testing.T) {
+	var mr MergeRequest
+	if err := json.Unmarshal([]byte(`{"detailed_merge_status":"some_future_status"}`), &mr); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if mr.DetailedMergeStatus != "some_future_status" {
+		t.Fatalf("Expected some_future_status but got %v", mr.DetailedMergeStatus)
+	}
+}
@@ -42,6 +42,10 @@ var (
 	ErrUserUnblockPrevented          = errors.New("Cannot unblock a user that is blocked by LDAP synchronization")
 )
 
+// ErrInvalidKey is returned when GitLab rejects the key material of a new SSH
+// or GPG key, for example because it is malformed or already in use.
+var ErrInvalidKey = errors.New("invalid key")
+
 // UsersService handles communication with the user related methods of
 // the GitLab API.
 //
@@ -216,6 +220,24 @@ func (s *UsersService) GetUser(user int, opt GetUsersOptions, options ...Request
 	return usr, resp, nil
 }
 
+// ListUserIdentities lists the provider identities of a user, for example
+// their SAML or LDAP identities. GitLab only includes identities in the user
+// details returned to administrators, so for other users the list is empty.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/api/users.html#single-user
+func (s *UsersService) ListUserIdentities(user int, options ...RequestOptionFunc) ([]*UserIdentity, *Response, error) {
+	usr, resp, err := s.GetUser(user, GetUsersOptions{}, options...)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	if usr.Identities == nil {
+		return []*UserIdentity{}, resp, nil
+	}
+
+	return usr.Identities, resp, nil
+}
+
 // CreateUserOptions represents the available CreateUser() options.
 //
 // GitLab API docs: https://docs.gitlab.com/ee/api/users.html#user-creation
@@ -607,6 +629,7 @@ type AddSSHKeyOptions struct {
 }
 
 // AddSSHKey creates a new key owned by the currently authenticated user.
+// The returned error matches ErrInvalidKey when GitLab rejects the key.
 //
 // GitLab API docs: https://docs.gitlab.com/ee/api/users.html#add-ssh-key
 func (s *UsersService) AddSSHKey(opt *AddSSHKeyOptions, options ...RequestOptionFunc) (*SSHKey, *Response, error) {
@@ -618,14 +641,14 @@ func (s *UsersService) AddSSHKey(opt *AddSSHKeyOptions, options ...RequestOption
 	k := new(SSHKey)
 	resp, err := s.client.Do(req, k)
 	if err != nil {
-		return nil, resp, err
+		return nil, resp, keyError(resp, err)
 	}
 
 	return k, resp, nil
 }
 
 // AddSSHKeyForUser creates new key owned by specified user. Available only for
-// admin.
+// admin. The returned error matches ErrInvalidKey when GitLab rejects the key.
 //
 // GitLab API docs: https://docs.gitlab.com/ee/api/users.html#add-ssh-key-for-user
 func (s *UsersService) AddSSHKeyForUser(user int, opt *AddSSHKeyOptions, options ...RequestOptionFunc) (*SSHKey, *Response, error) {
@@ -639,12 +662,21 @@ func (s *UsersService) AddSSHKeyForUser(user int, opt *AddSSHKeyOptions, options
 	k := new(SSHKey)
 	resp, err := s.client.Do(req, k)
 	if err != nil {
-		return nil, resp, err
+		return nil, resp, keyError(resp, err)
 	}
 
 	return k, resp, nil
 }
 
+// keyError wraps the 400 GitLab returns for rejected key material so it
+// matches ErrInvalidKey.
+func keyError(resp *Response, err error) error {
+	if resp != nil && resp.StatusCode == http.StatusBadRequest {
+		return &sentinelError{sentinel: ErrInvalidKey, err: err}
+	}
+	return err
+}
+
 // DeleteSSHKey deletes key owned by currently authenticated user. This is an
 // idempotent function and calling it on a key that is already deleted or not
 // available results in 200 OK.
@@ -733,6 +765,7 @@ type AddGPGKeyOptions struct {
 }
 
 // AddGPGKey creates a new GPG key owned by the currently authenticated user.
+// The returned error matches ErrInvalidKey when GitLab rejects the key.
 //
 // GitLab API docs: https://docs.gitlab.com/ee/api/users.html#add-a-gpg-key
 func (s *UsersService) AddGPGKey(opt *AddGPGKeyOptions, options ...RequestOptionFunc) (*GPGKey, *Response, error) {
@@ -744,7 +777,7 @@ func (s *UsersService) AddGPGKey(opt *AddGPGKeyOptions, options ...RequestOption
 	k := new(GPGKey)
 	resp, err := s.client.Do(req, k)
 	if err != nil {
-		return nil, resp, err
+		return nil, resp, keyError(resp, err)
 	}
 
 	return k, resp, nil
@@ -806,6 +839,7 @@ func (s *UsersService) GetGPGKeyForUser(user, key int, options ...RequestOptionF
 }
 
 // AddGPGKeyForUser creates new GPG key owned by the specified user.
+// The returned error matches ErrInvalidKey when GitLab rejects the key.
 //
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/users.html#add-a-gpg-key-for-a-given-user
@@ -820,7 +854,7 @@ func (s *UsersService) AddGPGKeyForUser(user int, opt *AddGPGKeyOptions, options
 	k := new(GPGKey)
 	resp, err := s.client.Do(req, k)
 	if err != nil {
-		return nil, resp, err
+		return nil, resp, keyError(resp, err)
 	}
 
 	return k, resp, nil
@@ -65,6 +65,28 @@ func TestGetUser(t *testing.T) {
 	require.Equal(t, want, user)
 }
 
+func TestListUserIdentities(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/users/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{"id":1,"identities":[{"provider":"group_saml","extern_uid":"yrnZW46BrtBFqM7xDzE7dddd"}]}`)
+	})
+	mux.HandleFunc("/api/v4/users/2", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{"id":2}`)
+	})
+
+	ids, _, err := client.Users.ListUserIdentities(1)
+	require.NoError(t, err)
+	require.Equal(t, []*UserIdentity{{Provider: "group_saml", ExternUID: "yrnZW46BrtBFqM7xDzE7dddd"}}, ids)
+
+	ids, _, err = client.Users.ListUserIdentities(2)
+	require.NoError(t, err)
+	require.NotNil(t, ids)
+	require.Empty(t, ids)
+}
+
 func TestGetUserAdmin(t *testing.T) {
 	mux, client := setup(t)
 
@@ -641,6 +663,43 @@ func TestGetSingleSSHKeyForUser(t *testing.T) {
 	}
 }
 
+func TestAddSSHKeyWithExpiresAt(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/user/keys", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testBody(t, r, `{"title":"CI","key":"ssh-ed25519 AAAA...","expires_at":"2025-01-31"}`)
+		fmt.Fprint(w, `{"id":1,"title":"CI","key":"ssh-ed25519 AAAA...","expires_at":"2025-01-31T00:00:00.000Z"}`)
+	})
+
+	expiresAt := ISOTime(time.Date(2025, time.January, 31, 0, 0, 0, 0, time.UTC))
+	key, _, err := client.Users.AddSSHKey(&AddSSHKeyOptions{
+		Title:     Ptr("CI"),
+		Key:       Ptr("ssh-ed25519 AAAA..."),
+		ExpiresAt: &expiresAt,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, time.Date(2025, time.January, 31, 0, 0, 0, 0, time.UTC), *key.ExpiresAt)
+}
+
+func TestAddGPGKeyInvalid(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/user/gpg_keys", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(w, `{"message":{"key":["is invalid"]}}`)
+	})
+
+	_, resp, err := client.Users.AddGPGKey(&AddGPGKeyOptions{Key: Ptr("not a key")})
+	assert.ErrorIs(t, err, ErrInvalidKey)
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+
+	var errResp *ErrorResponse
+	require.ErrorAs(t, err, &errResp)
+	assert.Contains(t, errResp.Message, "is invalid")
+}
+
 func TestDisableUser2FA(t *testing.T) {
 	mux, client := setup(t)
 
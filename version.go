@@ -16,7 +16,12 @@
 
 package gitlab
 
-import "net/http"
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+)
 
 // VersionService handles communication with the GitLab server instance to
 // retrieve its version information via the GitLab API.
@@ -38,6 +43,75 @@ func (s Version) String() string {
 	return Stringify(s)
 }
 
+// SemanticVersion parses the version of the GitLab instance.
+func (s Version) SemanticVersion() (SemanticVersion, error) {
+	return ParseVersion(s.Version)
+}
+
+// SemanticVersion represents a parsed GitLab version like "16.4.1-ee". The
+// suffix holds anything after the version numbers, such as the edition or a
+// pre-release marker, without the leading dash.
+type SemanticVersion struct {
+	Major  int
+	Minor  int
+	Patch  int
+	Suffix string
+}
+
+// ParseVersion parses a GitLab version string as returned by the version and
+// metadata endpoints. A missing patch number is treated as 0.
+func ParseVersion(v string) (SemanticVersion, error) {
+	var sv SemanticVersion
+
+	numbers := v
+	if i := strings.IndexAny(v, "-+"); i >= 0 {
+		numbers, sv.Suffix = v[:i], v[i+1:]
+	}
+
+	parts := strings.Split(numbers, ".")
+	if len(parts) < 2 || len(parts) > 3 {
+		return SemanticVersion{}, fmt.Errorf("invalid version %q", v)
+	}
+
+	fields := []*int{&sv.Major, &sv.Minor, &sv.Patch}
+	for i, p := range parts {
+		n, err := strconv.Atoi(p)
+		if err != nil || n < 0 {
+			return SemanticVersion{}, fmt.Errorf("invalid version %q", v)
+		}
+		*fields[i] = n
+	}
+
+	return sv, nil
+}
+
+// Compare compares the version numbers of v and o, ignoring their suffixes.
+// It returns -1 when v is lower than o, 1 when v is higher and 0 otherwise.
+func (v SemanticVersion) Compare(o SemanticVersion) int {
+	for _, d := range []int{v.Major - o.Major, v.Minor - o.Minor, v.Patch - o.Patch} {
+		switch {
+		case d < 0:
+			return -1
+		case d > 0:
+			return 1
+		}
+	}
+	return 0
+}
+
+// AtLeast reports whether v is the given version or a later one.
+func (v SemanticVersion) AtLeast(major, minor, patch int) bool {
+	return v.Compare(SemanticVersion{Major: major, Minor: minor, Patch: patch}) >= 0
+}
+
+func (v SemanticVersion) String() string {
+	s := fmt.Sprintf("%d.%d.%d", v.Major, v.Minor, v.Patch)
+	if v.Suffix != "" {
+		s += "-" + v.Suffix
+	}
+	return s
+}
+
 // GetVersion gets a GitLab server instance version; it is only available to
 // authenticated users.
 //
@@ -42,3 +42,53 @@ func TestGetVersion(t *testing.T) {
 		t.Errorf("Version.GetVersion returned %+v, want %+v", version, want)
 	}
 }
+
+func TestParseVersion(t *testing.T) {
+	tests := []struct {
+		in   string
+		want SemanticVersion
+	}{
+		{"11.3.4-ee", SemanticVersion{Major: 11, Minor: 3, Patch: 4, Suffix: "ee"}},
+		{"15.6.0-pre", SemanticVersion{Major: 15, Minor: 6, Suffix: "pre"}},
+		{"15.6.0-rc2", SemanticVersion{Major: 15, Minor: 6, Suffix: "rc2"}},
+		{"16.10.2", SemanticVersion{Major: 16, Minor: 10, Patch: 2}},
+		{"17.0", SemanticVersion{Major: 17}},
+	}
+
+	for _, tt := range tests {
+		got, err := ParseVersion(tt.in)
+		if err != nil {
+			t.Errorf("ParseVersion(%q) returned error: %v", tt.in, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("ParseVersion(%q) = %+v, want %+v", tt.in, got, tt.want)
+		}
+	}
+
+	for _, in := range []string{"", "16", "16.x.1", "1.2.3.4", "-ee"} {
+		if _, err := ParseVersion(in); err == nil {
+			t.Errorf("ParseVersion(%q) returned no error", in)
+		}
+	}
+}
+
+func TestSemanticVersionCompare(t *testing.T) {
+	v, err := Version{Version: "16.4.1-ee"}.SemanticVersion()
+	if err != nil {
+		t.Fatalf("Version.SemanticVersion returned error: %v", err)
+	}
+
+	if !v.AtLeast(16, 4, 0) || !v.AtLeast(16, 4, 1) || v.AtLeast(16, 10, 0) || v.AtLeast(17, 0, 0) {
+		t.Errorf("AtLeast returned unexpected results for %s", v)
+	}
+	if got := v.Compare(SemanticVersion{Major: 16, Minor: 4, Patch: 1}); got != 0 {
+		t.Errorf("Compare ignoring suffix = %d, want 0", got)
+	}
+	if got := v.Compare(SemanticVersion{Major: 9, Minor: 9, Patch: 9}); got != 1 {
+		t.Errorf("Compare with lower version = %d, want 1", got)
+	}
+	if got, want := v.String(), "16.4.1-ee"; got != want {
+		t.Errorf("String = %s, want %s", got, want)
+	}
+}
@@ -0,0 +1,116 @@
+//
+// Copyright 2021, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+// Package webhooktest provides sample webhook payloads and helpers to test
+// webhook handlers built on the event parsers of the gitlab package.
+//
+// Example usage:
+//
+//	func TestHandler(t *testing.T) {
+//	    f, _ := webhooktest.Lookup("push")
+//	    req := webhooktest.NewWebhookRequest(f.EventType, f.Payload)
+//	    rec := httptest.NewRecorder()
+//	    handler.ServeHTTP(rec, req)
+//	    ...
+//	}
+package webhooktest
+
+import (
+	"bytes"
+	"embed"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/xanzy/go-gitlab"
+)
+
+//go:embed payloads/*.json
+var payloads embed.FS
+
+// Fixture represents a sample webhook payload together with the event type
+// GitLab sends it with.
+type Fixture struct {
+	Name      string
+	EventType gitlab.EventType
+	Payload   []byte
+}
+
+// fixtures maps the name of every payload file to its event type.
+var fixtures = []struct {
+	name      string
+	eventType gitlab.EventType
+}{
+	{"build", gitlab.EventTypeBuild},
+	{"deployment", gitlab.EventTypeDeployment},
+	{"feature_flag", gitlab.EventTypeFeatureFlag},
+	{"group_merge_request", gitlab.EventTypeMergeRequest},
+	{"issue", gitlab.EventTypeIssue},
+	{"job", gitlab.EventTypeJob},
+	{"member", gitlab.EventTypeMember},
+	{"merge_request", gitlab.EventTypeMergeRequest},
+	{"note_commit", gitlab.EventTypeNote},
+	{"note_issue", gitlab.EventTypeNote},
+	{"note_merge_request", gitlab.EventTypeNote},
+	{"note_snippet", gitlab.EventTypeNote},
+	{"pipeline", gitlab.EventTypePipeline},
+	{"push", gitlab.EventTypePush},
+	{"release", gitlab.EventTypeRelease},
+	{"resource_access_token_group", gitlab.EventTypeResourceAccessToken},
+	{"resource_access_token_project", gitlab.EventTypeResourceAccessToken},
+	{"service_merge_request", gitlab.EventTypeServiceHook},
+	{"subgroup", gitlab.EventTypeSubGroup},
+	{"tag_push", gitlab.EventTypeTagPush},
+	{"wiki_page", gitlab.EventTypeWikiPage},
+}
+
+// Fixtures returns all sample webhook payloads, at least one for every event
+// type supported by gitlab.ParseWebhook.
+func Fixtures() []Fixture {
+	fs := make([]Fixture, 0, len(fixtures))
+	for _, f := range fixtures {
+		fs = append(fs, load(f.name, f.eventType))
+	}
+	return fs
+}
+
+// Lookup returns the sample webhook payload with the given name, for example
+// "push" or "note_merge_request".
+func Lookup(name string) (Fixture, bool) {
+	for _, f := range fixtures {
+		if f.name == name {
+			return load(f.name, f.eventType), true
+		}
+	}
+	return Fixture{}, false
+}
+
+func load(name string, eventType gitlab.EventType) Fixture {
+	payload, err := payloads.ReadFile("payloads/" + name + ".json")
+	if err != nil {
+		// All payloads are embedded at build time, so this cannot happen.
+		panic(err)
+	}
+	return Fixture{Name: name, EventType: eventType, Payload: payload}
+}
+
+// NewWebhookRequest returns an incoming request as GitLab sends it for a
+// webhook event, suitable for passing to an http.Handler.
+func NewWebhookRequest(eventType gitlab.EventType, payload []byte) *http.Request {
+	r := httptest.NewRequest(http.MethodPost, "/", bytes.NewReader(payload))
+	r.Header.Set("Content-Type", "application/json")
+	r.Header.Set("X-Gitlab-Event", string(eventType))
+	return r
+}
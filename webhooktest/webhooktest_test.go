@@ -0,0 +1,94 @@
+//
+// Copyright 2021, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package webhooktest
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/xanzy/go-gitlab"
+)
+
+func TestFixturesRoundTrip(t *testing.T) {
+	want := map[string]string{
+		"build":                         "*gitlab.BuildEvent",
+		"deployment":                    "*gitlab.DeploymentEvent",
+		"feature_flag":                  "*gitlab.FeatureFlagEvent",
+		"group_merge_request":           "*gitlab.MergeEvent",
+		"issue":                         "*gitlab.IssueEvent",
+		"job":                           "*gitlab.JobEvent",
+		"member":                        "*gitlab.MemberEvent",
+		"merge_request":                 "*gitlab.MergeEvent",
+		"note_commit":                   "*gitlab.CommitCommentEvent",
+		"note_issue":                    "*gitlab.IssueCommentEvent",
+		"note_merge_request":            "*gitlab.MergeCommentEvent",
+		"note_snippet":                  "*gitlab.SnippetCommentEvent",
+		"pipeline":                      "*gitlab.PipelineEvent",
+		"push":                          "*gitlab.PushEvent",
+		"release":                       "*gitlab.ReleaseEvent",
+		"resource_access_token_group":   "*gitlab.GroupResourceAccessTokenEvent",
+		"resource_access_token_project": "*gitlab.ProjectResourceAccessTokenEvent",
+		"service_merge_request":         "*gitlab.MergeEvent",
+		"subgroup":                      "*gitlab.SubGroupEvent",
+		"tag_push":                      "*gitlab.TagEvent",
+		"wiki_page":                     "*gitlab.WikiPageEvent",
+	}
+
+	fixtures := Fixtures()
+	require.Len(t, fixtures, len(want))
+
+	for _, f := range fixtures {
+		t.Run(f.Name, func(t *testing.T) {
+			r := NewWebhookRequest(f.EventType, f.Payload)
+			assert.Equal(t, f.EventType, gitlab.HookEventType(r))
+			assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+
+			body, err := io.ReadAll(r.Body)
+			require.NoError(t, err)
+
+			event, err := gitlab.ParseWebhook(gitlab.HookEventType(r), body)
+			require.NoError(t, err)
+			assert.Equal(t, want[f.Name], fmt.Sprintf("%T", event))
+
+			// Encoding the parsed event and parsing it again must not
+			// lose any information. The encodings are compared rather
+			// than the events, as the location of parsed times may differ.
+			encoded, err := json.Marshal(event)
+			require.NoError(t, err)
+			reparsed, err := gitlab.ParseWebhook(f.EventType, encoded)
+			require.NoError(t, err)
+			assert.Equal(t, want[f.Name], fmt.Sprintf("%T", reparsed))
+			reencoded, err := json.Marshal(reparsed)
+			require.NoError(t, err)
+			assert.Equal(t, string(encoded), string(reencoded))
+		})
+	}
+}
+
+func TestLookup(t *testing.T) {
+	f, ok := Lookup("push")
+	require.True(t, ok)
+	assert.Equal(t, gitlab.EventTypePush, f.EventType)
+	assert.NotEmpty(t, f.Payload)
+
+	_, ok = Lookup("unknown")
+	assert.False(t, ok)
+}
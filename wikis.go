@@ -16,11 +16,16 @@
 package gitlab
 
 import (
+	"errors"
 	"fmt"
 	"net/http"
 	"net/url"
 )
 
+// ErrWikiPageExists is returned when a wiki page is moved to a slug that is
+// already taken by another page.
+var ErrWikiPageExists = errors.New("a wiki page with the target slug already exists")
+
 // WikisService handles communication with the wikis related methods of
 // the Gitlab API.
 //
@@ -159,7 +164,9 @@ type EditWikiPageOptions struct {
 }
 
 // EditWikiPage Updates an existing wiki page. At least one parameter is
-// required to update the wiki page.
+// required to update the wiki page. Note that changing the title also changes
+// the slug of the page, so links to the old slug will no longer resolve. Use
+// MoveWikiPage to get both the old and the new slug.
 //
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/wikis.html#edit-an-existing-wiki-page
@@ -184,6 +191,31 @@ func (s *WikisService) EditWikiPage(pid interface{}, slug string, opt *EditWikiP
 	return w, resp, nil
 }
 
+// WikiPageMove represents the result of moving a wiki page.
+type WikiPageMove struct {
+	OldSlug string
+	NewSlug string
+	Page    *Wiki
+}
+
+// MoveWikiPage renames a wiki page by changing its title, which also changes
+// its slug. The returned error matches ErrWikiPageExists when the new slug is
+// already in use.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/wikis.html#edit-an-existing-wiki-page
+func (s *WikisService) MoveWikiPage(pid interface{}, slug, title string, options ...RequestOptionFunc) (*WikiPageMove, *Response, error) {
+	w, resp, err := s.EditWikiPage(pid, slug, &EditWikiPageOptions{Title: Ptr(title)}, options...)
+	if err != nil {
+		if resp != nil && resp.StatusCode == http.StatusConflict {
+			return nil, resp, &sentinelError{sentinel: ErrWikiPageExists, err: err}
+		}
+		return nil, resp, err
+	}
+
+	return &WikiPageMove{OldSlug: slug, NewSlug: w.Slug, Page: w}, resp, nil
+}
+
 // DeleteWikiPage deletes a wiki page with a given slug.
 //
 // GitLab API docs:
@@ -16,9 +16,11 @@
 package gitlab
 
 import (
+	"errors"
 	"fmt"
 	"net/http"
 	"reflect"
+	"strings"
 	"testing"
 )
 
@@ -194,3 +196,55 @@ func TestDeleteWikiPage(t *testing.T) {
 		t.Errorf("Wiki.DeleteWikiPage returned error: %v", err)
 	}
 }
+
+func TestMoveWikiPage(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/1/wikis/old-page", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPut)
+		testBody(t, r, `{"title":"New Page"}`)
+		fmt.Fprint(w, `{"content":"content","format":"markdown","slug":"New-Page","title":"New Page"}`)
+	})
+
+	move, _, err := client.Wikis.MoveWikiPage(1, "old-page", "New Page")
+	if err != nil {
+		t.Fatalf("Wikis.MoveWikiPage returned error: %v", err)
+	}
+
+	want := &WikiPageMove{
+		OldSlug: "old-page",
+		NewSlug: "New-Page",
+		Page: &Wiki{
+			Content: "content",
+			Format:  WikiFormatMarkdown,
+			Slug:    "New-Page",
+			Title:   "New Page",
+		},
+	}
+	if !reflect.DeepEqual(want, move) {
+		t.Errorf("Wikis.MoveWikiPage returned %+v, want %+v", move, want)
+	}
+}
+
+func TestMoveWikiPageConflict(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/1/wikis/old-page", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPut)
+		w.WriteHeader(http.StatusConflict)
+		fmt.Fprint(w, `{"message":"Duplicate page: A page with that title already exists"}`)
+	})
+
+	_, _, err := client.Wikis.MoveWikiPage(1, "old-page", "Existing")
+	if !errors.Is(err, ErrWikiPageExists) {
+		t.Errorf("Wikis.MoveWikiPage returned error %v, want %v", err, ErrWikiPageExists)
+	}
+
+	var errResp *ErrorResponse
+	if !errors.As(err, &errResp) {
+		t.Fatalf("expected the error to wrap an *ErrorResponse, got %T", err)
+	}
+	if !strings.Contains(errResp.Message, "Duplicate page") {
+		t.Errorf("expected GitLab's message to be kept, got %q", errResp.Message)
+	}
+}